@@ -10,6 +10,7 @@ import (
 
 	"goload/internal/handler/grpc"
 	"goload/internal/handler/http"
+	"goload/internal/handler/jobs"
 	"goload/internal/handler/mq"
 )
 
@@ -17,6 +18,7 @@ type Server struct {
 	grpcServer      grpc.Server
 	httpServer      http.Server
 	messageConsumer mq.MessageConsumer
+	jobScheduler    jobs.Scheduler
 	logger          *zap.Logger
 }
 
@@ -24,12 +26,14 @@ func NewServer(
 	grpcServer grpc.Server,
 	httpServer http.Server,
 	messageConsumer mq.MessageConsumer,
+	jobScheduler jobs.Scheduler,
 	logger *zap.Logger,
 ) *Server {
 	return &Server{
 		grpcServer:      grpcServer,
 		httpServer:      httpServer,
 		messageConsumer: messageConsumer,
+		jobScheduler:    jobScheduler,
 		logger:          logger,
 	}
 }
@@ -53,6 +57,11 @@ func (s Server) Start() error {
 		s.logger.With(zap.Error(consumerStartErr)).Info("message queue consumer stopped")
 	}()
 
+	go func() {
+		err := s.jobScheduler.Start(ctx)
+		s.logger.With(zap.Error(err)).Info("job scheduler stopped")
+	}()
+
 	<-ctx.Done()
 	s.logger.Info("shutting down...")
 
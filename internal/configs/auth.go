@@ -2,19 +2,99 @@ package configs
 
 import "time"
 
+type HashAlgorithm string
+
+const (
+	HashAlgorithmBcrypt   HashAlgorithm = "bcrypt"
+	HashAlgorithmArgon2id HashAlgorithm = "argon2id"
+)
+
+// Argon2id configures the argon2id password hash, Memory is in KiB.
+type Argon2id struct {
+	Memory      uint32 `yaml:"memory"`
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+	SaltLength  uint32 `yaml:"salt_length"`
+	KeyLength   uint32 `yaml:"key_length"`
+}
+
+// Hash configures how new passwords are hashed. Hashes made by either algorithm can always be verified, since the
+// algorithm and its parameters are encoded in the hash. Cost is only used by bcrypt, which is the default.
 type Hash struct {
-	Cost int `yaml:"cost"`
+	Algorithm HashAlgorithm `yaml:"algorithm"`
+	Cost      int           `yaml:"cost"`
+	Argon2id  Argon2id      `yaml:"argon2id"`
+}
+
+// SigningKey configures the keys tokens are signed with. MasterKey is an optional base64 encoded 32 bytes AES key
+// that encrypts the private keys stored in the database.
+type SigningKey struct {
+	RotationInterval string `yaml:"rotation_interval"`
+	GracePeriod      string `yaml:"grace_period"`
+	MasterKey        string `yaml:"master_key"`
 }
 
+func (s SigningKey) GetRotationIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(s.RotationInterval)
+}
+
+func (s SigningKey) GetGracePeriodDuration() (time.Duration, error) {
+	return time.ParseDuration(s.GracePeriod)
+}
+
+// Token configures the access tokens, which are short lived, and the refresh tokens that get new ones.
 type Token struct {
-	ExpiresIn string `yaml:"expires_in"`
+	ExpiresIn             string     `yaml:"expires_in"`
+	RefreshTokenExpiresIn string     `yaml:"refresh_token_expires_in"`
+	SigningKey            SigningKey `yaml:"signing_key"`
 }
 
 func (t Token) GetExpiresInDuration() (time.Duration, error) {
 	return time.ParseDuration(t.ExpiresIn)
 }
 
+func (t Token) GetRefreshTokenExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(t.RefreshTokenExpiresIn)
+}
+
+// LoginThrottle configures how failed logins are throttled. After the free attempts are used up, every further
+// failure locks the account or client IP out for twice as long as the previous one, starting at InitialLockout
+// and capped at MaxLockout. Failures are forgotten once FailureWindow passes without a new one.
+type LoginThrottle struct {
+	FailureWindow        string `yaml:"failure_window"`
+	AccountFreeAttempts  int64  `yaml:"account_free_attempts"`
+	ClientIPFreeAttempts int64  `yaml:"client_ip_free_attempts"`
+	InitialLockout       string `yaml:"initial_lockout"`
+	MaxLockout           string `yaml:"max_lockout"`
+}
+
+func (l LoginThrottle) GetFailureWindowDuration() (time.Duration, error) {
+	return time.ParseDuration(l.FailureWindow)
+}
+
+func (l LoginThrottle) GetInitialLockoutDuration() (time.Duration, error) {
+	return time.ParseDuration(l.InitialLockout)
+}
+
+func (l LoginThrottle) GetMaxLockoutDuration() (time.Duration, error) {
+	return time.ParseDuration(l.MaxLockout)
+}
+
+type PasswordReset struct {
+	TokenExpiresIn string `yaml:"token_expires_in"`
+}
+
+func (p PasswordReset) GetTokenExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(p.TokenExpiresIn)
+}
+
+// Auth configures authentication. Every account is created with the user role, and only an admin can change roles,
+// so the accounts named in AdminAccountNames are promoted to admin whenever the server starts. An account that does
+// not exist yet is skipped until the next start, register it and restart the server to get the first admin.
 type Auth struct {
-	Hash  Hash
-	Token Token
+	Hash              Hash
+	Token             Token
+	LoginThrottle     LoginThrottle `yaml:"login_throttle"`
+	PasswordReset     PasswordReset `yaml:"password_reset"`
+	AdminAccountNames []string      `yaml:"admin_account_names"`
 }
@@ -20,6 +20,7 @@ type Config struct {
 	Cache    Cache    `yaml:"cache"`
 	MQ       MQ       `yaml:"mq"`
 	Download Download `yaml:"download"`
+	Notifier Notifier `yaml:"notifier"`
 }
 
 func NewConfig(filePath ConfigFilePath) (Config, error) {
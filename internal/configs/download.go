@@ -1,5 +1,7 @@
 package configs
 
+import "time"
+
 type DownloadMode string
 
 const (
@@ -7,11 +9,94 @@ const (
 	DownloadModeS3    DownloadMode = "s3"
 )
 
+type Retry struct {
+	MaxAttempts    int    `yaml:"max_attempts"`
+	InitialBackoff string `yaml:"initial_backoff"`
+	MaxBackoff     string `yaml:"max_backoff"`
+	CheckInterval  string `yaml:"check_interval"`
+}
+
+func (r Retry) GetInitialBackoffDuration() (time.Duration, error) {
+	return time.ParseDuration(r.InitialBackoff)
+}
+
+func (r Retry) GetMaxBackoffDuration() (time.Duration, error) {
+	return time.ParseDuration(r.MaxBackoff)
+}
+
+func (r Retry) GetCheckIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(r.CheckInterval)
+}
+
+// Heartbeat configures how a worker shows it is still executing a download task, and how long it may stay silent
+// before the task is considered stuck.
+type Heartbeat struct {
+	Interval      string `yaml:"interval"`
+	Timeout       string `yaml:"timeout"`
+	CheckInterval string `yaml:"check_interval"`
+}
+
+func (h Heartbeat) GetIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(h.Interval)
+}
+
+func (h Heartbeat) GetTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(h.Timeout)
+}
+
+func (h Heartbeat) GetCheckIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(h.CheckInterval)
+}
+
+// ContentType restricts the kinds of files that can be downloaded, by media types such as "video/mp4" or "image/*".
+// Both the Content-Type header and the type sniffed from the first bytes of the file are checked. An empty
+// AllowedTypes allows every type that is not denied.
+type ContentType struct {
+	AllowedTypes []string `yaml:"allowed_types"`
+	DeniedTypes  []string `yaml:"denied_types"`
+}
+
+// Quota limits how much each account may download, a zero limit means unlimited. Download tasks over the
+// concurrency limit are put back to pending and tried again after DeferInterval. MaxFileSizeInBytes is the default
+// of every account, an account can be given its own limit, and a download task can ask for a lower one.
+type Quota struct {
+	MaxDownloadingTaskCount uint64 `yaml:"max_downloading_task_count"`
+	MaxPendingTaskCount     uint64 `yaml:"max_pending_task_count"`
+	MaxStoredBytes          int64  `yaml:"max_stored_bytes"`
+	MaxFileSizeInBytes      int64  `yaml:"max_file_size_in_bytes"`
+	DeferInterval           string `yaml:"defer_interval"`
+}
+
+func (q Quota) GetDeferIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(q.DeferInterval)
+}
+
+// Egress restricts where download tasks may connect to. The private, loopback, link-local and other special
+// purpose ranges are always blocked, BlockedCIDRs and BlockedPorts add to them. AllowedCIDRs and AllowedHosts
+// override the blocklist for trusted destinations, such as an internal mirror.
+type Egress struct {
+	AllowedSchemes []string `yaml:"allowed_schemes"`
+	BlockedCIDRs   []string `yaml:"blocked_cidrs"`
+	BlockedPorts   []uint16 `yaml:"blocked_ports"`
+	AllowedCIDRs   []string `yaml:"allowed_cidrs"`
+	AllowedHosts   []string `yaml:"allowed_hosts"`
+	MaxRedirects   int      `yaml:"max_redirects"`
+}
+
 type Download struct {
-	Mode              DownloadMode `yaml:"mode"`
-	DownloadDirectory string       `yaml:"download_directory"`
-	Bucket            string       `yaml:"bucket"`
-	Address           string       `yaml:"address"`
-	Username          string       `yaml:"username"`
-	Password          string       `yaml:"password"`
+	Mode                  DownloadMode `yaml:"mode"`
+	DownloadDirectory     string       `yaml:"download_directory"`
+	Bucket                string       `yaml:"bucket"`
+	Address               string       `yaml:"address"`
+	Username              string       `yaml:"username"`
+	Password              string       `yaml:"password"`
+	UseSSL                bool         `yaml:"use_ssl"`
+	ResumeMaxAttempts     int          `yaml:"resume_max_attempts"`
+	SegmentCount          int          `yaml:"segment_count"`
+	MinSegmentSizeInBytes int64        `yaml:"min_segment_size_in_bytes"`
+	Retry                 Retry        `yaml:"retry"`
+	Heartbeat             Heartbeat    `yaml:"heartbeat"`
+	Quota                 Quota        `yaml:"quota"`
+	Egress                Egress       `yaml:"egress"`
+	ContentType           ContentType  `yaml:"content_type"`
 }
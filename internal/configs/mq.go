@@ -1,6 +1,19 @@
 package configs
 
+import "time"
+
 type MQ struct {
-	Addresses []string `yaml:"addresses"`
-	ClientID  string   `yaml:"client_id"`
+	Addresses           []string `yaml:"addresses"`
+	ClientID            string   `yaml:"client_id"`
+	OutboxRelayInterval string   `yaml:"outbox_relay_interval"`
+	// OutboxRetention is how long the outbox messages are kept after they are sent.
+	OutboxRetention string `yaml:"outbox_retention"`
+}
+
+func (m MQ) GetOutboxRelayIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(m.OutboxRelayInterval)
+}
+
+func (m MQ) GetOutboxRetentionDuration() (time.Duration, error) {
+	return time.ParseDuration(m.OutboxRetention)
 }
@@ -0,0 +1,15 @@
+package configs
+
+type NotifierType string
+
+const (
+	NotifierTypeLog  NotifierType = "log"
+	NotifierTypeFile NotifierType = "file"
+)
+
+// Notifier configures how messages meant for account owners, such as password reset tokens, are delivered. Both
+// types are meant for local use, FilePath is only used by the file type.
+type Notifier struct {
+	Type     NotifierType `yaml:"type"`
+	FilePath string       `yaml:"file_path"`
+}
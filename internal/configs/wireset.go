@@ -12,4 +12,5 @@ var WireSet = wire.NewSet(
 	wire.FieldsOf(new(Config), "Cache"),
 	wire.FieldsOf(new(Config), "MQ"),
 	wire.FieldsOf(new(Config), "Download"),
+	wire.FieldsOf(new(Config), "Notifier"),
 )
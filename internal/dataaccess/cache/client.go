@@ -4,9 +4,16 @@ import (
 	"context"
 	"fmt"
 	"goload/internal/configs"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrCacheMiss = status.Error(codes.NotFound, "cache miss")
 )
 
 type Client interface {
@@ -14,6 +21,16 @@ type Client interface {
 	Get(ctx context.Context, key string) (any, error)
 	AddToSet(ctx context.Context, key string, val ...any) error
 	IsDataInSet(ctx context.Context, key string, val any) (bool, error)
+	// Increment atomically adds one to the counter at key and returns the new value. The counter expires ttl after
+	// its last increment.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// parseInt64CacheEntry reads back an int64 that was Set into the cache. Redis hands the value back as a string while
+// the in-memory cache keeps the original int64.
+func parseInt64CacheEntry(cacheEntry any) (int64, error) {
+	return strconv.ParseInt(fmt.Sprint(cacheEntry), 10, 64)
 }
 
 func NewClient(
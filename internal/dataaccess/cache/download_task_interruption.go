@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"goload/internal/utils"
+)
+
+const (
+	// The flag only has to outlive the worker that is still executing the task.
+	downloadTaskInterruptionTTL = 24 * time.Hour
+)
+
+// DownloadTaskInterruption tells the worker executing a download task that it has to stop, for example because the
+// task was paused or canceled. Every Set bumps the version of the flag, a worker remembers the version it started
+// with and only stops once the version changes, so a flag meant for an earlier execution is ignored without
+// comparing the clocks of different machines.
+type DownloadTaskInterruption interface {
+	Set(ctx context.Context, downloadTaskID uint64) error
+	// Get returns the current version of the flag, 0 if it was never set.
+	Get(ctx context.Context, downloadTaskID uint64) (int64, error)
+}
+
+type downloadTaskInterruption struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewDownloadTaskInterruption(
+	client Client,
+	logger *zap.Logger,
+) DownloadTaskInterruption {
+	return &downloadTaskInterruption{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (d downloadTaskInterruption) getDownloadTaskInterruptionCacheKey(downloadTaskID uint64) string {
+	return fmt.Sprintf("download_task_interruption:%d", downloadTaskID)
+}
+
+// Set implements DownloadTaskInterruption.
+func (d downloadTaskInterruption) Set(ctx context.Context, downloadTaskID uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
+
+	cacheKey := d.getDownloadTaskInterruptionCacheKey(downloadTaskID)
+	if _, err := d.client.Increment(ctx, cacheKey, downloadTaskInterruptionTTL); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set download task interruption into cache")
+		return err
+	}
+
+	return nil
+}
+
+// Get implements DownloadTaskInterruption.
+func (d downloadTaskInterruption) Get(ctx context.Context, downloadTaskID uint64) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
+
+	cacheKey := d.getDownloadTaskInterruptionCacheKey(downloadTaskID)
+	cacheEntry, err := d.client.Get(ctx, cacheKey)
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return 0, nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get download task interruption from cache")
+		return 0, err
+	}
+
+	version, err := parseInt64CacheEntry(cacheEntry)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse download task interruption from cache")
+		return 0, err
+	}
+
+	return version, nil
+}
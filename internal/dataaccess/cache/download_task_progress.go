@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/utils"
+)
+
+const (
+	downloadTaskProgressTTL = time.Hour
+)
+
+var (
+	errInvalidDownloadTaskProgressCacheEntry = status.Error(codes.Internal, "invalid download task progress cache entry")
+)
+
+type DownloadTaskProgressEntry struct {
+	DownloadedBytes int64 `json:"downloaded_bytes"`
+	TotalBytes      int64 `json:"total_bytes"`
+	BytesPerSecond  int64 `json:"bytes_per_second"`
+	ETASeconds      int64 `json:"eta_seconds"`
+}
+
+type DownloadTaskProgress interface {
+	Set(ctx context.Context, downloadTaskID uint64, progress DownloadTaskProgressEntry) error
+	Get(ctx context.Context, downloadTaskID uint64) (DownloadTaskProgressEntry, bool, error)
+}
+
+type downloadTaskProgress struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewDownloadTaskProgress(
+	client Client,
+	logger *zap.Logger,
+) DownloadTaskProgress {
+	return &downloadTaskProgress{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (d downloadTaskProgress) getDownloadTaskProgressCacheKey(downloadTaskID uint64) string {
+	return fmt.Sprintf("download_task_progress:%d", downloadTaskID)
+}
+
+// Set implements DownloadTaskProgress.
+func (d downloadTaskProgress) Set(ctx context.Context, downloadTaskID uint64, progress DownloadTaskProgressEntry) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
+
+	encodedProgress, err := json.Marshal(progress)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal download task progress")
+		return err
+	}
+
+	cacheKey := d.getDownloadTaskProgressCacheKey(downloadTaskID)
+	if err = d.client.Set(ctx, cacheKey, string(encodedProgress), downloadTaskProgressTTL); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set download task progress into cache")
+		return err
+	}
+
+	return nil
+}
+
+// Get implements DownloadTaskProgress.
+func (d downloadTaskProgress) Get(ctx context.Context, downloadTaskID uint64) (DownloadTaskProgressEntry, bool, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
+
+	cacheKey := d.getDownloadTaskProgressCacheKey(downloadTaskID)
+	cacheEntry, err := d.client.Get(ctx, cacheKey)
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return DownloadTaskProgressEntry{}, false, nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get download task progress from cache")
+		return DownloadTaskProgressEntry{}, false, err
+	}
+
+	encodedProgress, ok := cacheEntry.(string)
+	if !ok {
+		logger.Error("cache entry is not of type string")
+		return DownloadTaskProgressEntry{}, false, errInvalidDownloadTaskProgressCacheEntry
+	}
+
+	progress := DownloadTaskProgressEntry{}
+	if err = json.Unmarshal([]byte(encodedProgress), &progress); err != nil {
+		logger.With(zap.Error(err)).Error("failed to unmarshal download task progress")
+		return DownloadTaskProgressEntry{}, false, errInvalidDownloadTaskProgressCacheEntry
+	}
+
+	return progress, true, nil
+}
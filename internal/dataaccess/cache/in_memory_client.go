@@ -2,47 +2,58 @@ package cache
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
-var (
-	errCacheMiss = status.Error(codes.Internal, "failed to set data into cache")
+const (
+	inMemoryExpiredKeySweepInterval = time.Minute
 )
 
 type inMemoryClient struct {
-	cache      map[string]any
-	cacheMutex *sync.Mutex
-	logger     *zap.Logger
+	cache       map[string]any
+	expiresAt   map[string]time.Time
+	lastSweptAt time.Time
+	cacheMutex  *sync.Mutex
+	logger      *zap.Logger
 }
 
 func NewInMemoryClient(
 	logger *zap.Logger,
 ) Client {
 	return &inMemoryClient{
-		cache:      make(map[string]any),
-		cacheMutex: new(sync.Mutex),
-		logger:     logger,
+		cache:       make(map[string]any),
+		expiresAt:   make(map[string]time.Time),
+		lastSweptAt: time.Now(),
+		cacheMutex:  new(sync.Mutex),
+		logger:      logger,
 	}
 }
 
 // Get implements Client.
 func (i *inMemoryClient) Get(ctx context.Context, key string) (any, error) {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	i.deleteIfExpired(key)
 	data, ok := i.cache[key]
 	if !ok {
-		return nil, errCacheMiss
+		return nil, ErrCacheMiss
 	}
 
 	return data, nil
 }
 
 // Set implements Client.
-func (i *inMemoryClient) Set(ctx context.Context, key string, val any, _ time.Duration) error {
+func (i *inMemoryClient) Set(ctx context.Context, key string, val any, ttl time.Duration) error {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
 	i.cache[key] = val
+	i.setTTL(key, ttl)
 	return nil
 }
 
@@ -51,8 +62,13 @@ func (i *inMemoryClient) AddToSet(ctx context.Context, key string, val ...any) e
 	i.cacheMutex.Lock()
 	defer i.cacheMutex.Unlock()
 
+	// Like a redis set, a value that is already in the set is not added again.
 	set := i.getSet(key)
-	set = append(set, val...)
+	for _, value := range val {
+		if !slices.Contains(set, value) {
+			set = append(set, value)
+		}
+	}
 	i.cache[key] = set
 
 	return nil
@@ -63,14 +79,63 @@ func (i *inMemoryClient) IsDataInSet(ctx context.Context, key string, val any) (
 	i.cacheMutex.Lock()
 	defer i.cacheMutex.Unlock()
 
-	set := i.getSet(key)
-	for i := range set {
-		if set[i] == val {
-			return true, nil
-		}
+	return slices.Contains(i.getSet(key), val), nil
+}
+
+// Increment implements Client.
+func (i *inMemoryClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	i.deleteIfExpired(key)
+	count, _ := i.cache[key].(int64)
+	count++
+	i.cache[key] = count
+	i.setTTL(key, ttl)
+
+	return count, nil
+}
+
+// Delete implements Client.
+func (i *inMemoryClient) Delete(ctx context.Context, key string) error {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	delete(i.cache, key)
+	delete(i.expiresAt, key)
+	return nil
+}
+
+// setTTL follows redis, where a zero ttl means that the key never expires.
+func (i *inMemoryClient) setTTL(key string, ttl time.Duration) {
+	i.deleteExpiredKeysIfDue()
+	if ttl <= 0 {
+		delete(i.expiresAt, key)
+		return
 	}
 
-	return false, nil
+	i.expiresAt[key] = time.Now().Add(ttl)
+}
+
+// deleteExpiredKeysIfDue drops every expired key once in a while, keys that are never read again would otherwise
+// stay in memory forever.
+func (i *inMemoryClient) deleteExpiredKeysIfDue() {
+	if time.Since(i.lastSweptAt) < inMemoryExpiredKeySweepInterval {
+		return
+	}
+
+	i.lastSweptAt = time.Now()
+	for key := range i.expiresAt {
+		i.deleteIfExpired(key)
+	}
+}
+
+func (i *inMemoryClient) deleteIfExpired(key string) {
+	expiresAt, ok := i.expiresAt[key]
+	if ok && time.Now().After(expiresAt) {
+		delete(i.cache, key)
+		delete(i.expiresAt, key)
+	}
 }
 
 func (c inMemoryClient) getSet(key string) []any {
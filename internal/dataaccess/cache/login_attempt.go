@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"goload/internal/utils"
+)
+
+// LoginAttempt keeps track of failed logins, keyed by whatever is being throttled (an account or a client IP), and
+// of the lockouts that are placed on a key after too many of them.
+type LoginAttempt interface {
+	// AddFailure records a failed login and returns how many failures there have been, counting only those that
+	// happened within window of the one before.
+	AddFailure(ctx context.Context, key string, window time.Duration) (int64, error)
+	ResetFailures(ctx context.Context, key string) error
+	Lock(ctx context.Context, key string, until time.Time) error
+	GetLockedUntil(ctx context.Context, key string) (time.Time, bool, error)
+}
+
+type loginAttempt struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewLoginAttempt(
+	client Client,
+	logger *zap.Logger,
+) LoginAttempt {
+	return &loginAttempt{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l loginAttempt) getLoginFailureCacheKey(key string) string {
+	return fmt.Sprintf("login_failure:%s", key)
+}
+
+func (l loginAttempt) getLoginLockoutCacheKey(key string) string {
+	return fmt.Sprintf("login_lockout:%s", key)
+}
+
+// AddFailure implements LoginAttempt.
+func (l loginAttempt) AddFailure(ctx context.Context, key string, window time.Duration) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", key))
+
+	failureCount, err := l.client.Increment(ctx, l.getLoginFailureCacheKey(key), window)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to add login failure into cache")
+		return 0, err
+	}
+
+	return failureCount, nil
+}
+
+// ResetFailures implements LoginAttempt.
+func (l loginAttempt) ResetFailures(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", key))
+
+	if err := l.client.Delete(ctx, l.getLoginFailureCacheKey(key)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to reset login failures in cache")
+		return err
+	}
+
+	return nil
+}
+
+// Lock implements LoginAttempt.
+func (l loginAttempt) Lock(ctx context.Context, key string, until time.Time) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).
+		With(zap.String("key", key)).
+		With(zap.Time("until", until))
+
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		return nil
+	}
+
+	if err := l.client.Set(ctx, l.getLoginLockoutCacheKey(key), until.UnixNano(), ttl); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set login lockout into cache")
+		return err
+	}
+
+	return nil
+}
+
+// GetLockedUntil implements LoginAttempt.
+func (l loginAttempt) GetLockedUntil(ctx context.Context, key string) (time.Time, bool, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", key))
+
+	cacheEntry, err := l.client.Get(ctx, l.getLoginLockoutCacheKey(key))
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return time.Time{}, false, nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to get login lockout from cache")
+		return time.Time{}, false, err
+	}
+
+	lockedUntilUnixNano, err := parseInt64CacheEntry(cacheEntry)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse login lockout from cache")
+		return time.Time{}, false, err
+	}
+
+	lockedUntil := time.Unix(0, lockedUntilUnixNano)
+	if !time.Now().Before(lockedUntil) {
+		return time.Time{}, false, nil
+	}
+
+	return lockedUntil, true, nil
+}
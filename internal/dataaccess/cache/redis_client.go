@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -14,10 +15,12 @@ import (
 )
 
 var (
-	errSetCacheDataFailed   = status.Error(codes.Internal, "failed to set data into cache")
-	errGetCacheDataFailed   = status.Error(codes.Internal, "failed to get data into cache")
-	errAddDataToSetFailed   = status.Error(codes.Internal, "failed to add data into cache's set")
-	errCheckCacheDataFailed = status.Error(codes.Internal, "failed to check if data in cache or not")
+	errSetCacheDataFailed    = status.Error(codes.Internal, "failed to set data into cache")
+	errGetCacheDataFailed    = status.Error(codes.Internal, "failed to get data into cache")
+	errAddDataToSetFailed    = status.Error(codes.Internal, "failed to add data into cache's set")
+	errCheckCacheDataFailed  = status.Error(codes.Internal, "failed to check if data in cache or not")
+	errIncrementDataFailed   = status.Error(codes.Internal, "failed to increment data in cache")
+	errDeleteCacheDataFailed = status.Error(codes.Internal, "failed to delete data from cache")
 )
 
 type redisClient struct {
@@ -45,6 +48,9 @@ func (r *redisClient) Get(ctx context.Context, key string) (any, error) {
 		With(zap.String("key", key))
 
 	val, err := r.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrCacheMiss
+	}
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get data from cache")
 		return nil, errGetCacheDataFailed
@@ -96,3 +102,32 @@ func (r *redisClient) IsDataInSet(ctx context.Context, key string, val any) (boo
 
 	return exist, nil
 }
+
+// Increment implements Client.
+func (r *redisClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger).
+		With(zap.String("key", key)).
+		With(zap.Duration("ttl", ttl))
+
+	pipeline := r.client.TxPipeline()
+	incrementCmd := pipeline.Incr(ctx, key)
+	pipeline.Expire(ctx, key, ttl)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to increment data in cache")
+		return 0, errIncrementDataFailed
+	}
+
+	return incrementCmd.Val(), nil
+}
+
+// Delete implements Client.
+func (r *redisClient) Delete(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.String("key", key))
+
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete data from cache")
+		return errDeleteCacheDataFailed
+	}
+
+	return nil
+}
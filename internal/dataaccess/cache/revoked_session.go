@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"goload/internal/utils"
+)
+
+// RevokedSession is the list of sessions that were logged out, whose access tokens must be rejected even though
+// they have not expired yet. Each session is kept under its own key, which expires once every access token of the
+// session has expired on its own.
+type RevokedSession interface {
+	// Add revokes the session for ttl, which is the lifetime of its access tokens.
+	Add(ctx context.Context, sessionID uint64, ttl time.Duration) error
+	Has(ctx context.Context, sessionID uint64) (bool, error)
+}
+
+type revokedSession struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewRevokedSession(
+	client Client,
+	logger *zap.Logger,
+) RevokedSession {
+	return &revokedSession{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r revokedSession) getRevokedSessionCacheKey(sessionID uint64) string {
+	return fmt.Sprintf("revoked_session:%d", sessionID)
+}
+
+// Add implements RevokedSession.
+func (r revokedSession) Add(ctx context.Context, sessionID uint64, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("session_id", sessionID))
+
+	if err := r.client.Set(ctx, r.getRevokedSessionCacheKey(sessionID), true, ttl); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add revoked session into cache")
+		return err
+	}
+
+	return nil
+}
+
+// Has implements RevokedSession.
+func (r revokedSession) Has(ctx context.Context, sessionID uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("session_id", sessionID))
+
+	if _, err := r.client.Get(ctx, r.getRevokedSessionCacheKey(sessionID)); err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return false, nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to check revoked session in cache")
+		return false, err
+	}
+
+	return true, nil
+}
@@ -4,6 +4,8 @@ import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
 	NewClient,
-	NewInMemoryClient,
-	NewRedisClient,
+	NewDownloadTaskInterruption,
+	NewDownloadTaskProgress,
+	NewLoginAttempt,
+	NewRevokedSession,
 )
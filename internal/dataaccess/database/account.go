@@ -2,10 +2,13 @@ package database
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/doug-martin/goqu/v9"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"goload/internal/generated/grpc/goload"
 )
 
 var (
@@ -13,20 +16,30 @@ var (
 )
 
 const (
-	TabNameAccounts            = "accounts"
-	ColNameAccountsID          = "id"
-	ColNameAccountsAccountName = "account_name"
+	TabNameAccounts                   = "accounts"
+	ColNameAccountsID                 = "id"
+	ColNameAccountsAccountName        = "account_name"
+	ColNameAccountsRole               = "role"
+	ColNameAccountsDisabledAt         = "disabled_at"
+	ColNameAccountsMaxFileSizeInBytes = "max_file_size_in_bytes"
 )
 
 type Account struct {
-	ID          uint64 `db:"id"`
-	AccountName string `db:"account_name"`
+	ID          uint64       `db:"id" goqu:"skipinsert,skipupdate"`
+	AccountName string       `db:"account_name" goqu:"skipupdate"`
+	Role        goload.Role  `db:"role"`
+	DisabledAt  sql.NullTime `db:"disabled_at"`
+	// MaxFileSizeInBytes overrides the configured maximum file size of the account when it is not zero.
+	MaxFileSizeInBytes int64 `db:"max_file_size_in_bytes"`
 }
 
 type AccountRepository interface {
 	CreateAccount(ctx context.Context, account Account) (uint64, error)
 	GetAccountByID(ctx context.Context, id uint64) (Account, error)
 	GetAccountByAccountName(ctx context.Context, accountName string) (Account, error)
+	GetAccountByIDWithXLock(ctx context.Context, id uint64) (Account, error)
+	GetAccountListByIDList(ctx context.Context, idList []uint64) ([]Account, error)
+	UpdateAccount(ctx context.Context, account Account) error
 	WithDatabase(database Database) AccountRepository
 }
 
@@ -97,6 +110,56 @@ func (a *accountRepository) GetAccountByID(ctx context.Context, id uint64) (Acco
 	return account, nil
 }
 
+// GetAccountByIDWithXLock implements AccountRepository.
+func (a *accountRepository) GetAccountByIDWithXLock(ctx context.Context, id uint64) (Account, error) {
+	account := Account{}
+
+	found, err := a.database.
+		From(TabNameAccounts).
+		Where(goqu.C(ColNameAccountsID).Eq(id)).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &account)
+	if err != nil {
+		return Account{}, err
+	}
+	if !found {
+		return Account{}, ErrAccountNotFound
+	}
+
+	return account, nil
+}
+
+// GetAccountListByIDList implements AccountRepository.
+func (a *accountRepository) GetAccountListByIDList(ctx context.Context, idList []uint64) ([]Account, error) {
+	accountList := make([]Account, 0)
+	if len(idList) == 0 {
+		return accountList, nil
+	}
+
+	err := a.database.
+		Select().
+		From(TabNameAccounts).
+		Where(goqu.C(ColNameAccountsID).In(idList)).
+		Executor().
+		ScanStructsContext(ctx, &accountList)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountList, nil
+}
+
+// UpdateAccount implements AccountRepository.
+func (a *accountRepository) UpdateAccount(ctx context.Context, account Account) error {
+	_, err := a.database.
+		Update(TabNameAccounts).
+		Set(account).
+		Where(goqu.C(ColNameAccountsID).Eq(account.ID)).
+		Executor().
+		ExecContext(ctx)
+	return err
+}
+
 // WithDatabase implements AccountRepository.
 func (a *accountRepository) WithDatabase(database Database) AccountRepository {
 	return &accountRepository{
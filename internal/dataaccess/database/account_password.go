@@ -26,6 +26,10 @@ type AccountPassword struct {
 type AccountPasswordRepository interface {
 	CreateAccountPassword(ctx context.Context, accountPassword AccountPassword) (uint64, error)
 	GetAccountPasswordByOfAccountID(ctx context.Context, ofAccountID uint64) (AccountPassword, error)
+	UpdateAccountPassword(ctx context.Context, accountPassword AccountPassword) error
+	// ReplaceAccountPasswordHash only updates the hash if it is still previousHash, so that a password changed in
+	// the meantime is not overwritten. It returns whether the hash was replaced.
+	ReplaceAccountPasswordHash(ctx context.Context, accountPassword AccountPassword, previousHash string) (bool, error)
 	WithDatabase(database Database) AccountPasswordRepository
 }
 
@@ -78,6 +82,44 @@ func (a *accountPasswordRepository) GetAccountPasswordByOfAccountID(ctx context.
 	return accountPassword, nil
 }
 
+// UpdateAccountPassword implements AccountPasswordRepository.
+func (a *accountPasswordRepository) UpdateAccountPassword(ctx context.Context, accountPassword AccountPassword) error {
+	_, err := a.database.
+		Update(TabNameAccountPasswords).
+		Set(goqu.Record{ColNameAccountPasswordsHash: accountPassword.Hash}).
+		Where(goqu.C(ColNameAccountPasswordsOfAccountID).Eq(accountPassword.OfAccountID)).
+		Executor().
+		ExecContext(ctx)
+	return err
+}
+
+// ReplaceAccountPasswordHash implements AccountPasswordRepository.
+func (a *accountPasswordRepository) ReplaceAccountPasswordHash(
+	ctx context.Context,
+	accountPassword AccountPassword,
+	previousHash string,
+) (bool, error) {
+	result, err := a.database.
+		Update(TabNameAccountPasswords).
+		Set(goqu.Record{ColNameAccountPasswordsHash: accountPassword.Hash}).
+		Where(
+			goqu.C(ColNameAccountPasswordsOfAccountID).Eq(accountPassword.OfAccountID),
+			goqu.C(ColNameAccountPasswordsHash).Eq(previousHash),
+		).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
 // WithDatabase implements AccountRepository.
 func (a *accountPasswordRepository) WithDatabase(database Database) AccountPasswordRepository {
 	return &accountPasswordRepository{
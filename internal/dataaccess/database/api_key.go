@@ -0,0 +1,161 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/utils"
+)
+
+var (
+	errCreateApiKeyFailed  = status.Error(codes.Internal, "failed to create api key")
+	errUpdateApiKeyFailed  = status.Error(codes.Internal, "failed to update api key")
+	errGetApiKeyFailed     = status.Error(codes.Internal, "failed to get api key")
+	errGetApiKeyListFailed = status.Error(codes.Internal, "failed to get api key list of account")
+
+	ErrApiKeyNotFound = status.Error(codes.NotFound, "api key not found")
+)
+
+const (
+	TabNameApiKeys            = "api_keys"
+	ColNameApiKeysID          = "id"
+	ColNameApiKeysOfAccountID = "of_account_id"
+	ColNameApiKeysName        = "name"
+	ColNameApiKeysHash        = "hash"
+	ColNameApiKeysScopes      = "scopes"
+	ColNameApiKeysCreatedAt   = "created_at"
+	ColNameApiKeysExpiresAt   = "expires_at"
+	ColNameApiKeysRevokedAt   = "revoked_at"
+)
+
+// ApiKey is a long lived credential of an account, meant for scripts. Scopes is a JSON array of the names of the
+// goload.ApiKeyScope values the key is granted.
+type ApiKey struct {
+	ID          uint64       `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64       `db:"of_account_id" goqu:"skipupdate"`
+	Name        string       `db:"name"`
+	Hash        string       `db:"hash"`
+	Scopes      string       `db:"scopes"`
+	CreatedAt   time.Time    `db:"created_at" goqu:"skipinsert,skipupdate"`
+	ExpiresAt   sql.NullTime `db:"expires_at"`
+	RevokedAt   sql.NullTime `db:"revoked_at"`
+}
+
+type ApiKeyRepository interface {
+	CreateApiKey(ctx context.Context, apiKey ApiKey) (uint64, time.Time, error)
+	UpdateApiKey(ctx context.Context, apiKey ApiKey) error
+	GetApiKeyByID(ctx context.Context, id uint64) (ApiKey, error)
+	GetApiKeyListByOfAccountID(ctx context.Context, accountID uint64) ([]ApiKey, error)
+	WithDatabase(database Database) ApiKeyRepository
+}
+
+type apiKeyRepository struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewApiKeyRepository(
+	database *goqu.Database,
+	logger *zap.Logger,
+) ApiKeyRepository {
+	return &apiKeyRepository{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateApiKey implements ApiKeyRepository. It returns the ID and the creation time of the api key.
+func (a *apiKeyRepository) CreateApiKey(ctx context.Context, apiKey ApiKey) (uint64, time.Time, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", apiKey.OfAccountID))
+
+	createdApiKey := ApiKey{}
+	_, err := a.database.
+		Insert(TabNameApiKeys).
+		Rows(goqu.Record{
+			ColNameApiKeysOfAccountID: apiKey.OfAccountID,
+			ColNameApiKeysName:        apiKey.Name,
+			ColNameApiKeysHash:        apiKey.Hash,
+			ColNameApiKeysScopes:      apiKey.Scopes,
+			ColNameApiKeysExpiresAt:   apiKey.ExpiresAt,
+		}).
+		Returning(ColNameApiKeysID, ColNameApiKeysCreatedAt).
+		Executor().
+		ScanStructContext(ctx, &createdApiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create api key")
+		return 0, time.Time{}, errCreateApiKeyFailed
+	}
+
+	return createdApiKey.ID, createdApiKey.CreatedAt, nil
+}
+
+// UpdateApiKey implements ApiKeyRepository.
+func (a *apiKeyRepository) UpdateApiKey(ctx context.Context, apiKey ApiKey) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", apiKey.ID))
+
+	if _, err := a.database.
+		Update(TabNameApiKeys).
+		Set(apiKey).
+		Where(goqu.C(ColNameApiKeysID).Eq(apiKey.ID)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update api key")
+		return errUpdateApiKeyFailed
+	}
+
+	return nil
+}
+
+// GetApiKeyByID implements ApiKeyRepository.
+func (a *apiKeyRepository) GetApiKeyByID(ctx context.Context, id uint64) (ApiKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	apiKey := ApiKey{}
+	found, err := a.database.
+		From(TabNameApiKeys).
+		Where(goqu.C(ColNameApiKeysID).Eq(id)).
+		ScanStructContext(ctx, &apiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key")
+		return ApiKey{}, errGetApiKeyFailed
+	}
+	if !found {
+		return ApiKey{}, ErrApiKeyNotFound
+	}
+
+	return apiKey, nil
+}
+
+// GetApiKeyListByOfAccountID implements ApiKeyRepository.
+func (a *apiKeyRepository) GetApiKeyListByOfAccountID(ctx context.Context, accountID uint64) ([]ApiKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	apiKeyList := make([]ApiKey, 0)
+	err := a.database.
+		Select().
+		From(TabNameApiKeys).
+		Where(goqu.C(ColNameApiKeysOfAccountID).Eq(accountID)).
+		Order(goqu.C(ColNameApiKeysID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &apiKeyList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key list of account")
+		return nil, errGetApiKeyListFailed
+	}
+
+	return apiKeyList, nil
+}
+
+// WithDatabase implements ApiKeyRepository.
+func (a *apiKeyRepository) WithDatabase(database Database) ApiKeyRepository {
+	return &apiKeyRepository{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"go.uber.org/zap"
@@ -13,42 +15,89 @@ import (
 )
 
 var (
-	errCreateDownloadTaskFailed  = status.Error(codes.Internal, "failed to create download task")
-	errDeleteDownloadTaskFailed  = status.Error(codes.Internal, "failed to delete download task")
-	errUpdateDownloadTaskFailed  = status.Error(codes.Internal, "failed to update download task")
-	errGetDownloadTaskListFailed = status.Error(codes.Internal, "failed to get download task list of account")
-	errCountDownloadTasksFailed  = status.Error(codes.Internal, "failed to count download task of account")
+	errCreateDownloadTaskFailed    = status.Error(codes.Internal, "failed to create download task")
+	errDeleteDownloadTaskFailed    = status.Error(codes.Internal, "failed to delete download task")
+	errUpdateDownloadTaskFailed    = status.Error(codes.Internal, "failed to update download task")
+	errGetDownloadTaskListFailed   = status.Error(codes.Internal, "failed to get download task list of account")
+	errCountDownloadTasksFailed    = status.Error(codes.Internal, "failed to count download task of account")
+	errGetDueDownloadTasksFailed   = status.Error(codes.Internal, "failed to get download tasks due for retry")
+	errUpdateHeartbeatFailed       = status.Error(codes.Internal, "failed to update download task heartbeat")
+	errGetStaleDownloadTasksFailed = status.Error(codes.Internal, "failed to get stale downloading download tasks")
+	errSumDownloadedBytesFailed    = status.Error(codes.Internal, "failed to sum downloaded bytes of account")
 
 	ErrDownloadTaskNotFound = status.Error(codes.NotFound, "download task not found")
 )
 
 const (
-	TabNameDownloadTasks               = "download_tasks"
-	ColNameDownloadTasksID             = "id"
-	ColNameDownloadTasksOfAccountID    = "of_account_id"
-	ColNameDownloadTasksDownloadType   = "download_type"
-	ColNameDownloadTasksURL            = "url"
-	ColNameDownloadTasksDownloadStatus = "download_status"
-	ColNameDownloadTasksMetadata       = "metadata"
+	TabNameDownloadTasks                   = "download_tasks"
+	ColNameDownloadTasksID                 = "id"
+	ColNameDownloadTasksOfAccountID        = "of_account_id"
+	ColNameDownloadTasksDownloadType       = "download_type"
+	ColNameDownloadTasksURL                = "url"
+	ColNameDownloadTasksDownloadStatus     = "download_status"
+	ColNameDownloadTasksMetadata           = "metadata"
+	ColNameDownloadTasksAttemptCount       = "attempt_count"
+	ColNameDownloadTasksNextAttemptAt      = "next_attempt_at"
+	ColNameDownloadTasksLastError          = "last_error"
+	ColNameDownloadTasksAttemptHistory     = "attempt_history"
+	ColNameDownloadTasksDownloadedBytes    = "downloaded_bytes"
+	ColNameDownloadTasksTotalBytes         = "total_bytes"
+	ColNameDownloadTasksBytesPerSecond     = "bytes_per_second"
+	ColNameDownloadTasksHeartbeatAt        = "heartbeat_at"
+	ColNameDownloadTasksMaxFileSizeInBytes = "max_file_size_in_bytes"
+	ColNameDownloadTasksCreatedAt          = "created_at"
+	ColNameDownloadTasksUpdatedAt          = "updated_at"
+	ColNameDownloadTasksCompletedAt        = "completed_at"
 )
 
 type DownloadTask struct {
-	ID             uint64                `db:"id" goqu:"skipinsert,skipupdate"`
-	OfAccountID    uint64                `db:"of_account_id" goqu:"skipupdate"`
-	DownloadType   goload.DownloadType   `db:"download_type"`
-	URL            string                `db:"url"`
-	DownloadStatus goload.DownloadStatus `db:"download_status"`
-	Metadata       string                `db:"metadata"`
+	ID              uint64                `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID     uint64                `db:"of_account_id" goqu:"skipupdate"`
+	DownloadType    goload.DownloadType   `db:"download_type"`
+	URL             string                `db:"url"`
+	DownloadStatus  goload.DownloadStatus `db:"download_status"`
+	Metadata        string                `db:"metadata"`
+	AttemptCount    uint32                `db:"attempt_count"`
+	NextAttemptAt   sql.NullTime          `db:"next_attempt_at"`
+	LastError       string                `db:"last_error"`
+	AttemptHistory  string                `db:"attempt_history"`
+	DownloadedBytes int64                 `db:"downloaded_bytes"`
+	TotalBytes      int64                 `db:"total_bytes"`
+	BytesPerSecond  int64                 `db:"bytes_per_second"`
+	// HeartbeatAt is only written by UpdateDownloadTaskHeartbeat, so that saving a stale copy of the task does not
+	// move it back.
+	HeartbeatAt sql.NullTime `db:"heartbeat_at" goqu:"skipupdate"`
+	// MaxFileSizeInBytes lowers the maximum file size of the account for this task when it is not zero.
+	MaxFileSizeInBytes int64     `db:"max_file_size_in_bytes" goqu:"skipupdate"`
+	CreatedAt          time.Time `db:"created_at" goqu:"skipinsert,skipupdate"`
+	// UpdatedAt is set by UpdateDownloadTask.
+	UpdatedAt time.Time `db:"updated_at" goqu:"skipinsert"`
+	// CompletedAt is set when the task succeeds, fails or is canceled.
+	CompletedAt sql.NullTime `db:"completed_at" goqu:"skipinsert"`
 }
 
 type DownloadTaskRepository interface {
-	CreateDownloadTask(ctx context.Context, downloadTask DownloadTask) (uint64, error)
+	CreateDownloadTask(ctx context.Context, downloadTask DownloadTask) (uint64, time.Time, error)
 	UpdateDownloadTask(ctx context.Context, downloadTask DownloadTask) (bool, error)
 	DeleteDownloadTask(ctx context.Context, id uint64) (bool, error)
 	GetDownloadTaskListByOfAccountID(ctx context.Context, accountID, offset, limit uint64) ([]DownloadTask, error)
 	CountDownloadTasksByOfAccountID(ctx context.Context, accountID uint64) (uint64, error)
+	CountDownloadTasksByOfAccountIDAndStatus(ctx context.Context, accountID uint64, downloadStatus goload.DownloadStatus) (uint64, error)
+	// SumDownloadedBytesByOfAccountID returns how many bytes the account's download tasks, other than the canceled
+	// ones, have stored.
+	SumDownloadedBytesByOfAccountID(ctx context.Context, accountID uint64) (int64, error)
+	GetDownloadTaskList(ctx context.Context, offset, limit uint64) ([]DownloadTask, error)
+	CountDownloadTasks(ctx context.Context) (uint64, error)
 	GetDownloadTaskByID(ctx context.Context, id uint64) (DownloadTask, error)
 	GetDownloadTaskByIDWithXLock(ctx context.Context, id uint64) (DownloadTask, error)
+	GetDueRetryDownloadTaskListWithXLock(ctx context.Context, now time.Time, limit uint64) ([]DownloadTask, error)
+	// UpdateDownloadTaskHeartbeat reports false if the download task is not downloading anymore.
+	UpdateDownloadTaskHeartbeat(ctx context.Context, id uint64, heartbeatAt time.Time) (bool, error)
+	// RenewDownloadTaskHeartbeat moves the heartbeat from lastHeartbeatAt to heartbeatAt. It reports false if the
+	// download task is not downloading anymore, or if its heartbeat is not lastHeartbeatAt because the task has been
+	// requeued and picked up again since.
+	RenewDownloadTaskHeartbeat(ctx context.Context, id uint64, lastHeartbeatAt, heartbeatAt time.Time) (bool, error)
+	GetStaleDownloadingDownloadTaskListWithXLock(ctx context.Context, staleBefore time.Time, limit uint64) ([]DownloadTask, error)
 	WithDatabase(database Database) DownloadTaskRepository
 }
 
@@ -67,29 +116,30 @@ func NewDownloadRepository(
 	}
 }
 
-// CreateDownloadTask implements DownloadTaskRepository.
-func (d *downloadTaskRepository) CreateDownloadTask(ctx context.Context, downloadTask DownloadTask) (uint64, error) {
+// CreateDownloadTask implements DownloadTaskRepository. It returns the ID and the creation time of the download task.
+func (d *downloadTaskRepository) CreateDownloadTask(ctx context.Context, downloadTask DownloadTask) (uint64, time.Time, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("task", downloadTask))
-	var id uint64
 
+	createdDownloadTask := DownloadTask{}
 	_, err := d.database.
 		Insert(TabNameDownloadTasks).
 		Rows(goqu.Record{
-			ColNameDownloadTasksOfAccountID:    downloadTask.OfAccountID,
-			ColNameDownloadTasksURL:            downloadTask.URL,
-			ColNameDownloadTasksDownloadType:   downloadTask.DownloadType,
-			ColNameDownloadTasksDownloadStatus: downloadTask.DownloadStatus,
-			ColNameDownloadTasksMetadata:       downloadTask.Metadata,
+			ColNameDownloadTasksOfAccountID:        downloadTask.OfAccountID,
+			ColNameDownloadTasksURL:                downloadTask.URL,
+			ColNameDownloadTasksDownloadType:       downloadTask.DownloadType,
+			ColNameDownloadTasksDownloadStatus:     downloadTask.DownloadStatus,
+			ColNameDownloadTasksMetadata:           downloadTask.Metadata,
+			ColNameDownloadTasksMaxFileSizeInBytes: downloadTask.MaxFileSizeInBytes,
 		}).
-		Returning("id").
+		Returning(ColNameDownloadTasksID, ColNameDownloadTasksCreatedAt).
 		Executor().
-		ScanValContext(ctx, &id)
+		ScanStructContext(ctx, &createdDownloadTask)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to create download task")
-		return 0, errCreateDownloadTaskFailed
+		return 0, time.Time{}, errCreateDownloadTaskFailed
 	}
 
-	return id, nil
+	return createdDownloadTask.ID, createdDownloadTask.CreatedAt, nil
 }
 
 // DeleteDownloadTask implements DownloadTaskRepository.
@@ -111,6 +161,8 @@ func (d *downloadTaskRepository) DeleteDownloadTask(ctx context.Context, id uint
 // UpdateDownloadTask implements DownloadTaskRepository.
 func (d *downloadTaskRepository) UpdateDownloadTask(ctx context.Context, downloadTask DownloadTask) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("task", downloadTask))
+
+	downloadTask.UpdatedAt = time.Now()
 	if _, err := d.database.
 		Update(TabNameDownloadTasks).
 		Set(downloadTask).
@@ -140,6 +192,52 @@ func (d *downloadTaskRepository) CountDownloadTasksByOfAccountID(ctx context.Con
 	return uint64(count), nil
 }
 
+// CountDownloadTasksByOfAccountIDAndStatus implements DownloadTaskRepository.
+func (d *downloadTaskRepository) CountDownloadTasksByOfAccountIDAndStatus(
+	ctx context.Context,
+	accountID uint64,
+	downloadStatus goload.DownloadStatus,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("account_id", accountID)).
+		With(zap.String("download_status", downloadStatus.String()))
+
+	count, err := d.database.
+		From(TabNameDownloadTasks).
+		Where(goqu.Ex{
+			ColNameDownloadTasksOfAccountID:    accountID,
+			ColNameDownloadTasksDownloadStatus: downloadStatus,
+		}).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to count download task of user by status")
+		return 0, errCountDownloadTasksFailed
+	}
+
+	return uint64(count), nil
+}
+
+// SumDownloadedBytesByOfAccountID implements DownloadTaskRepository.
+func (d *downloadTaskRepository) SumDownloadedBytesByOfAccountID(ctx context.Context, accountID uint64) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("account_id", accountID))
+
+	var downloadedBytes int64
+	_, err := d.database.
+		From(TabNameDownloadTasks).
+		Select(goqu.COALESCE(goqu.SUM(ColNameDownloadTasksDownloadedBytes), 0)).
+		Where(
+			goqu.C(ColNameDownloadTasksOfAccountID).Eq(accountID),
+			goqu.C(ColNameDownloadTasksDownloadStatus).Neq(goload.DownloadStatus_Canceled),
+		).
+		ScanValContext(ctx, &downloadedBytes)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to sum downloaded bytes of user")
+		return 0, errSumDownloadedBytesFailed
+	}
+
+	return downloadedBytes, nil
+}
+
 // GetDownloadTaskListByOfAccountID implements DownloadTaskRepository.
 func (d *downloadTaskRepository) GetDownloadTaskListByOfAccountID(ctx context.Context, accountID uint64, offset uint64, limit uint64) ([]DownloadTask, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).
@@ -152,6 +250,7 @@ func (d *downloadTaskRepository) GetDownloadTaskListByOfAccountID(ctx context.Co
 		Select().
 		From(TabNameDownloadTasks).
 		Where(goqu.Ex{ColNameDownloadTasksOfAccountID: accountID}).
+		Order(goqu.C(ColNameDownloadTasksID).Desc()).
 		Offset(uint(offset)).
 		Limit(uint(limit)).
 		Executor().
@@ -164,6 +263,44 @@ func (d *downloadTaskRepository) GetDownloadTaskListByOfAccountID(ctx context.Co
 	return downloadTaskList, nil
 }
 
+// CountDownloadTasks implements DownloadTaskRepository.
+func (d *downloadTaskRepository) CountDownloadTasks(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	count, err := d.database.
+		From(TabNameDownloadTasks).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to count download tasks")
+		return 0, errCountDownloadTasksFailed
+	}
+
+	return uint64(count), nil
+}
+
+// GetDownloadTaskList implements DownloadTaskRepository.
+func (d *downloadTaskRepository) GetDownloadTaskList(ctx context.Context, offset uint64, limit uint64) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("offset", offset)).
+		With(zap.Uint64("limit", limit))
+
+	downloadTaskList := make([]DownloadTask, 0)
+	err := d.database.
+		Select().
+		From(TabNameDownloadTasks).
+		Order(goqu.C(ColNameDownloadTasksID).Desc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task list")
+		return nil, errGetDownloadTaskListFailed
+	}
+
+	return downloadTaskList, nil
+}
+
 // GetDownloadTaskByID implements DownloadTaskRepository.
 func (d *downloadTaskRepository) GetDownloadTaskByID(ctx context.Context, id uint64) (DownloadTask, error) {
 	downloadTask := DownloadTask{}
@@ -201,6 +338,128 @@ func (d *downloadTaskRepository) GetDownloadTaskByIDWithXLock(ctx context.Contex
 	return downloadTask, nil
 }
 
+// GetDueRetryDownloadTaskListWithXLock implements DownloadTaskRepository.
+func (d *downloadTaskRepository) GetDueRetryDownloadTaskListWithXLock(
+	ctx context.Context,
+	now time.Time,
+	limit uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("limit", limit))
+
+	downloadTaskList := make([]DownloadTask, 0)
+	err := d.database.
+		Select().
+		From(TabNameDownloadTasks).
+		Where(
+			goqu.C(ColNameDownloadTasksDownloadStatus).Eq(goload.DownloadStatus_Pending),
+			goqu.C(ColNameDownloadTasksNextAttemptAt).Lte(now),
+		).
+		Order(goqu.C(ColNameDownloadTasksNextAttemptAt).Asc()).
+		Limit(uint(limit)).
+		ForUpdate(goqu.SkipLocked).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download tasks due for retry")
+		return nil, errGetDueDownloadTasksFailed
+	}
+
+	return downloadTaskList, nil
+}
+
+// UpdateDownloadTaskHeartbeat implements DownloadTaskRepository.
+func (d *downloadTaskRepository) UpdateDownloadTaskHeartbeat(ctx context.Context, id uint64, heartbeatAt time.Time) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	result, err := d.database.
+		Update(TabNameDownloadTasks).
+		Set(goqu.Record{ColNameDownloadTasksHeartbeatAt: heartbeatAt}).
+		Where(
+			goqu.C(ColNameDownloadTasksID).Eq(id),
+			goqu.C(ColNameDownloadTasksDownloadStatus).Eq(goload.DownloadStatus_Downloading),
+		).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update download task heartbeat")
+		return false, errUpdateHeartbeatFailed
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get affected rows of download task heartbeat update")
+		return false, errUpdateHeartbeatFailed
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// RenewDownloadTaskHeartbeat implements DownloadTaskRepository.
+func (d *downloadTaskRepository) RenewDownloadTaskHeartbeat(
+	ctx context.Context,
+	id uint64,
+	lastHeartbeatAt time.Time,
+	heartbeatAt time.Time,
+) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	result, err := d.database.
+		Update(TabNameDownloadTasks).
+		Set(goqu.Record{ColNameDownloadTasksHeartbeatAt: heartbeatAt}).
+		Where(
+			goqu.C(ColNameDownloadTasksID).Eq(id),
+			goqu.C(ColNameDownloadTasksDownloadStatus).Eq(goload.DownloadStatus_Downloading),
+			goqu.C(ColNameDownloadTasksHeartbeatAt).Eq(lastHeartbeatAt),
+		).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to renew download task heartbeat")
+		return false, errUpdateHeartbeatFailed
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get affected rows of download task heartbeat renewal")
+		return false, errUpdateHeartbeatFailed
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// GetStaleDownloadingDownloadTaskListWithXLock implements DownloadTaskRepository.
+func (d *downloadTaskRepository) GetStaleDownloadingDownloadTaskListWithXLock(
+	ctx context.Context,
+	staleBefore time.Time,
+	limit uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("limit", limit))
+
+	downloadTaskList := make([]DownloadTask, 0)
+	err := d.database.
+		Select().
+		From(TabNameDownloadTasks).
+		Where(
+			goqu.C(ColNameDownloadTasksDownloadStatus).Eq(goload.DownloadStatus_Downloading),
+			// Tasks that started downloading before heartbeats were introduced never got one.
+			goqu.Or(
+				goqu.C(ColNameDownloadTasksHeartbeatAt).IsNull(),
+				goqu.C(ColNameDownloadTasksHeartbeatAt).Lt(staleBefore),
+			),
+		).
+		Order(goqu.C(ColNameDownloadTasksHeartbeatAt).Asc().NullsFirst()).
+		Limit(uint(limit)).
+		ForUpdate(goqu.SkipLocked).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get stale downloading download tasks")
+		return nil, errGetStaleDownloadTasksFailed
+	}
+
+	return downloadTaskList, nil
+}
+
 // WithDatabase implements DownloadTaskRepository.
 func (d *downloadTaskRepository) WithDatabase(database Database) DownloadTaskRepository {
 	return &downloadTaskRepository{
@@ -0,0 +1,18 @@
+-- +migrate Up
+ALTER TABLE download_tasks
+    ADD COLUMN IF NOT EXISTS attempt_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_error TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS attempt_history TEXT NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS download_tasks_download_status_next_attempt_at_idx
+    ON download_tasks (download_status, next_attempt_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS download_tasks_download_status_next_attempt_at_idx;
+
+ALTER TABLE download_tasks
+    DROP COLUMN IF EXISTS attempt_history,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempt_count;
@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE download_tasks
+    ADD COLUMN IF NOT EXISTS downloaded_bytes BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS total_bytes BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS bytes_per_second BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    DROP COLUMN IF EXISTS bytes_per_second,
+    DROP COLUMN IF EXISTS total_bytes,
+    DROP COLUMN IF EXISTS downloaded_bytes;
@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id BIGSERIAL PRIMARY KEY,
+    topic VARCHAR(256) NOT NULL,
+    payload BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_messages_unsent_idx ON outbox_messages (id) WHERE sent_at IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS outbox_messages_unsent_idx;
+DROP TABLE IF EXISTS outbox_messages;
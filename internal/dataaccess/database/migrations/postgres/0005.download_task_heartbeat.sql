@@ -0,0 +1,12 @@
+-- +migrate Up
+ALTER TABLE download_tasks
+    ADD COLUMN IF NOT EXISTS heartbeat_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS download_tasks_download_status_heartbeat_at_idx
+    ON download_tasks (download_status, heartbeat_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS download_tasks_download_status_heartbeat_at_idx;
+
+ALTER TABLE download_tasks
+    DROP COLUMN IF EXISTS heartbeat_at;
@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE public_keys
+    ADD COLUMN IF NOT EXISTS private_key BYTEA,
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+-- Keys created before rotation lost their private key at restart and only have to verify the tokens they already
+-- signed, which live for a day with the default configuration.
+UPDATE public_keys SET expires_at = NOW() + INTERVAL '1 day' WHERE expires_at IS NULL;
+
+ALTER TABLE public_keys ALTER COLUMN expires_at SET NOT NULL;
+
+-- +migrate Down
+ALTER TABLE public_keys
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS private_key;
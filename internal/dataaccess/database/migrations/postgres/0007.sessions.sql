@@ -0,0 +1,19 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sessions (
+    id BIGSERIAL PRIMARY KEY,
+    of_account_id BIGINT NOT NULL,
+    refresh_token_hash VARCHAR(64) NOT NULL UNIQUE,
+    previous_refresh_token_hash VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+);
+
+CREATE INDEX IF NOT EXISTS sessions_previous_refresh_token_hash_idx ON sessions (previous_refresh_token_hash);
+CREATE INDEX IF NOT EXISTS sessions_of_account_id_idx ON sessions (of_account_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS sessions_of_account_id_idx;
+DROP INDEX IF EXISTS sessions_previous_refresh_token_hash_idx;
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    of_account_id BIGINT NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    hash VARCHAR(128) NOT NULL,
+    scopes TEXT NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_of_account_id_idx ON api_keys (of_account_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS api_keys_of_account_id_idx;
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS role SMALLINT NOT NULL DEFAULT 1;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMPTZ;
+
+-- +migrate Down
+ALTER TABLE accounts DROP COLUMN IF EXISTS disabled_at;
+ALTER TABLE accounts DROP COLUMN IF EXISTS role;
@@ -0,0 +1,19 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS url_policies (
+    id BIGSERIAL PRIMARY KEY,
+    of_account_id BIGINT,
+    action SMALLINT NOT NULL,
+    host_glob VARCHAR(256) NOT NULL DEFAULT '',
+    url_regex VARCHAR(512) NOT NULL DEFAULT '',
+    scheme VARCHAR(16) NOT NULL DEFAULT '',
+    port INTEGER NOT NULL DEFAULT 0,
+    description VARCHAR(256) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+);
+
+CREATE INDEX IF NOT EXISTS url_policies_of_account_id_idx ON url_policies (of_account_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS url_policies_of_account_id_idx;
+DROP TABLE IF EXISTS url_policies;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS max_file_size_in_bytes BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE download_tasks ADD COLUMN IF NOT EXISTS max_file_size_in_bytes BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE download_tasks DROP COLUMN IF EXISTS max_file_size_in_bytes;
+ALTER TABLE accounts DROP COLUMN IF EXISTS max_file_size_in_bytes;
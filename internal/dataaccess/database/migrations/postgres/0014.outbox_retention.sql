@@ -0,0 +1,5 @@
+-- +migrate Up
+CREATE INDEX IF NOT EXISTS outbox_messages_sent_at_idx ON outbox_messages (sent_at) WHERE sent_at IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS outbox_messages_sent_at_idx;
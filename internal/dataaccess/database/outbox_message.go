@@ -0,0 +1,155 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/utils"
+)
+
+var (
+	errCreateOutboxMessageFailed     = status.Error(codes.Internal, "failed to create outbox message")
+	errGetUnsentOutboxMessagesFailed = status.Error(codes.Internal, "failed to get unsent outbox messages")
+	errMarkOutboxMessageSentFailed   = status.Error(codes.Internal, "failed to mark outbox message as sent")
+	errDeleteSentOutboxMessageFailed = status.Error(codes.Internal, "failed to delete sent outbox messages")
+)
+
+const (
+	TabNameOutboxMessages          = "outbox_messages"
+	ColNameOutboxMessagesID        = "id"
+	ColNameOutboxMessagesTopic     = "topic"
+	ColNameOutboxMessagesPayload   = "payload"
+	ColNameOutboxMessagesCreatedAt = "created_at"
+	ColNameOutboxMessagesSentAt    = "sent_at"
+)
+
+// OutboxMessage is a message queue message that is written in the same transaction as the change it announces,
+// and published once that transaction is committed.
+type OutboxMessage struct {
+	ID        uint64       `db:"id" goqu:"skipinsert,skipupdate"`
+	Topic     string       `db:"topic"`
+	Payload   []byte       `db:"payload"`
+	CreatedAt time.Time    `db:"created_at" goqu:"skipinsert,skipupdate"`
+	SentAt    sql.NullTime `db:"sent_at"`
+}
+
+type OutboxMessageRepository interface {
+	CreateOutboxMessage(ctx context.Context, outboxMessage OutboxMessage) (uint64, error)
+	GetUnsentOutboxMessageListWithXLock(ctx context.Context, limit uint64) ([]OutboxMessage, error)
+	MarkOutboxMessageSent(ctx context.Context, id uint64, sentAt time.Time) error
+	DeleteOutboxMessageListSentBefore(ctx context.Context, sentBefore time.Time) (int64, error)
+	WithDatabase(database Database) OutboxMessageRepository
+}
+
+type outboxMessageRepository struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewOutboxMessageRepository(
+	database *goqu.Database,
+	logger *zap.Logger,
+) OutboxMessageRepository {
+	return &outboxMessageRepository{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateOutboxMessage implements OutboxMessageRepository.
+func (o *outboxMessageRepository) CreateOutboxMessage(ctx context.Context, outboxMessage OutboxMessage) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.String("topic", outboxMessage.Topic))
+	var id uint64
+
+	_, err := o.database.
+		Insert(TabNameOutboxMessages).
+		Rows(goqu.Record{
+			ColNameOutboxMessagesTopic:   outboxMessage.Topic,
+			ColNameOutboxMessagesPayload: outboxMessage.Payload,
+		}).
+		Returning(ColNameOutboxMessagesID).
+		Executor().
+		ScanValContext(ctx, &id)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create outbox message")
+		return 0, errCreateOutboxMessageFailed
+	}
+
+	return id, nil
+}
+
+// GetUnsentOutboxMessageListWithXLock implements OutboxMessageRepository.
+func (o *outboxMessageRepository) GetUnsentOutboxMessageListWithXLock(ctx context.Context, limit uint64) ([]OutboxMessage, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Uint64("limit", limit))
+
+	outboxMessageList := make([]OutboxMessage, 0)
+	err := o.database.
+		Select().
+		From(TabNameOutboxMessages).
+		Where(goqu.C(ColNameOutboxMessagesSentAt).IsNull()).
+		Order(goqu.C(ColNameOutboxMessagesID).Asc()).
+		Limit(uint(limit)).
+		ForUpdate(goqu.SkipLocked).
+		Executor().
+		ScanStructsContext(ctx, &outboxMessageList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get unsent outbox messages")
+		return nil, errGetUnsentOutboxMessagesFailed
+	}
+
+	return outboxMessageList, nil
+}
+
+// MarkOutboxMessageSent implements OutboxMessageRepository.
+func (o *outboxMessageRepository) MarkOutboxMessageSent(ctx context.Context, id uint64, sentAt time.Time) error {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Uint64("id", id))
+
+	if _, err := o.database.
+		Update(TabNameOutboxMessages).
+		Set(goqu.Record{ColNameOutboxMessagesSentAt: sentAt}).
+		Where(goqu.Ex{ColNameOutboxMessagesID: id}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to mark outbox message as sent")
+		return errMarkOutboxMessageSentFailed
+	}
+
+	return nil
+}
+
+// DeleteOutboxMessageListSentBefore implements OutboxMessageRepository. It returns the number of deleted messages.
+func (o *outboxMessageRepository) DeleteOutboxMessageListSentBefore(ctx context.Context, sentBefore time.Time) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Time("sent_before", sentBefore))
+
+	result, err := o.database.
+		Delete(TabNameOutboxMessages).
+		Where(goqu.C(ColNameOutboxMessagesSentAt).Lt(sentBefore)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete sent outbox messages")
+		return 0, errDeleteSentOutboxMessageFailed
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get rows affected")
+		return 0, errDeleteSentOutboxMessageFailed
+	}
+
+	return rowsAffected, nil
+}
+
+// WithDatabase implements OutboxMessageRepository.
+func (o *outboxMessageRepository) WithDatabase(database Database) OutboxMessageRepository {
+	return &outboxMessageRepository{
+		database: database,
+		logger:   o.logger,
+	}
+}
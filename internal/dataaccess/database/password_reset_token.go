@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/utils"
+)
+
+var (
+	errCreatePasswordResetTokenFailed = status.Error(codes.Internal, "failed to create password reset token")
+	errUpdatePasswordResetTokenFailed = status.Error(codes.Internal, "failed to update password reset token")
+	errGetPasswordResetTokenFailed    = status.Error(codes.Internal, "failed to get password reset token")
+
+	ErrPasswordResetTokenNotFound = status.Error(codes.NotFound, "password reset token not found")
+)
+
+const (
+	TabNamePasswordResetTokens            = "password_reset_tokens"
+	ColNamePasswordResetTokensID          = "id"
+	ColNamePasswordResetTokensOfAccountID = "of_account_id"
+	ColNamePasswordResetTokensTokenHash   = "token_hash"
+	ColNamePasswordResetTokensCreatedAt   = "created_at"
+	ColNamePasswordResetTokensExpiresAt   = "expires_at"
+	ColNamePasswordResetTokensUsedAt      = "used_at"
+)
+
+// PasswordResetToken lets the owner of an account set a new password without knowing the old one. Only the hash of
+// the token is stored, and a token can only be used once.
+type PasswordResetToken struct {
+	ID          uint64       `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64       `db:"of_account_id" goqu:"skipupdate"`
+	TokenHash   string       `db:"token_hash" goqu:"skipupdate"`
+	CreatedAt   time.Time    `db:"created_at" goqu:"skipinsert,skipupdate"`
+	ExpiresAt   time.Time    `db:"expires_at"`
+	UsedAt      sql.NullTime `db:"used_at"`
+}
+
+type PasswordResetTokenRepository interface {
+	CreatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) (uint64, error)
+	UpdatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) error
+	GetPasswordResetTokenByTokenHashWithXLock(ctx context.Context, tokenHash string) (PasswordResetToken, error)
+	WithDatabase(database Database) PasswordResetTokenRepository
+}
+
+type passwordResetTokenRepository struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewPasswordResetTokenRepository(
+	database *goqu.Database,
+	logger *zap.Logger,
+) PasswordResetTokenRepository {
+	return &passwordResetTokenRepository{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreatePasswordResetToken implements PasswordResetTokenRepository.
+func (p *passwordResetTokenRepository) CreatePasswordResetToken(
+	ctx context.Context,
+	passwordResetToken PasswordResetToken,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("of_account_id", passwordResetToken.OfAccountID))
+
+	var id uint64
+	_, err := p.database.
+		Insert(TabNamePasswordResetTokens).
+		Rows(goqu.Record{
+			ColNamePasswordResetTokensOfAccountID: passwordResetToken.OfAccountID,
+			ColNamePasswordResetTokensTokenHash:   passwordResetToken.TokenHash,
+			ColNamePasswordResetTokensExpiresAt:   passwordResetToken.ExpiresAt,
+		}).
+		Returning(ColNamePasswordResetTokensID).
+		Executor().
+		ScanValContext(ctx, &id)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create password reset token")
+		return 0, errCreatePasswordResetTokenFailed
+	}
+
+	return id, nil
+}
+
+// UpdatePasswordResetToken implements PasswordResetTokenRepository.
+func (p *passwordResetTokenRepository) UpdatePasswordResetToken(
+	ctx context.Context,
+	passwordResetToken PasswordResetToken,
+) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("id", passwordResetToken.ID))
+
+	if _, err := p.database.
+		Update(TabNamePasswordResetTokens).
+		Set(passwordResetToken).
+		Where(goqu.C(ColNamePasswordResetTokensID).Eq(passwordResetToken.ID)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update password reset token")
+		return errUpdatePasswordResetTokenFailed
+	}
+
+	return nil
+}
+
+// GetPasswordResetTokenByTokenHashWithXLock implements PasswordResetTokenRepository.
+func (p *passwordResetTokenRepository) GetPasswordResetTokenByTokenHashWithXLock(
+	ctx context.Context,
+	tokenHash string,
+) (PasswordResetToken, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	passwordResetToken := PasswordResetToken{}
+	found, err := p.database.
+		From(TabNamePasswordResetTokens).
+		Where(goqu.C(ColNamePasswordResetTokensTokenHash).Eq(tokenHash)).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &passwordResetToken)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get password reset token by token hash")
+		return PasswordResetToken{}, errGetPasswordResetTokenFailed
+	}
+	if !found {
+		return PasswordResetToken{}, ErrPasswordResetTokenNotFound
+	}
+
+	return passwordResetToken, nil
+}
+
+// WithDatabase implements PasswordResetTokenRepository.
+func (p *passwordResetTokenRepository) WithDatabase(database Database) PasswordResetTokenRepository {
+	return &passwordResetTokenRepository{
+		database: database,
+		logger:   p.logger,
+	}
+}
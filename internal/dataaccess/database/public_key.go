@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 
@@ -14,19 +15,33 @@ var (
 )
 
 const (
-	TabNamePublicKeys          = "public_keys"
-	ColNamePublicKeysID        = "id"
-	ColNamePublicKeysPublicKey = "public_key"
+	TabNamePublicKeys           = "public_keys"
+	ColNamePublicKeysID         = "id"
+	ColNamePublicKeysPublicKey  = "public_key"
+	ColNamePublicKeysPrivateKey = "private_key"
+	ColNamePublicKeysCreatedAt  = "created_at"
+	ColNamePublicKeysExpiresAt  = "expires_at"
 )
 
+// PublicKey is a token signing key pair. PrivateKey is empty for keys created before signing keys were persisted,
+// and encrypted when a master key is configured.
 type PublicKey struct {
-	ID        uint64 `db:"id"`
-	PublicKey string `db:"public_key"`
+	ID         uint64    `db:"id"`
+	PublicKey  string    `db:"public_key"`
+	PrivateKey []byte    `db:"private_key"`
+	CreatedAt  time.Time `db:"created_at"`
+	ExpiresAt  time.Time `db:"expires_at"`
 }
 
 type PublicKeyRepository interface {
 	CreatePublicKey(ctx context.Context, publicKey PublicKey) (uint64, error)
 	GetPublicKeyByID(ctx context.Context, id uint64) (PublicKey, error)
+	// GetLatestSigningPublicKey returns the most recently created key that has a private key.
+	GetLatestSigningPublicKey(ctx context.Context) (PublicKey, error)
+	GetUnexpiredPublicKeyList(ctx context.Context, now time.Time) ([]PublicKey, error)
+	// LockPublicKeys blocks other transactions from creating keys until the current transaction ends, so that
+	// only one replica rotates the signing key.
+	LockPublicKeys(ctx context.Context) error
 	WithDatabase(database Database) PublicKeyRepository
 }
 
@@ -36,7 +51,9 @@ type publicKeyRepository struct {
 
 // WithDatabase implements PublicKeyRepository.
 func (p *publicKeyRepository) WithDatabase(database Database) PublicKeyRepository {
-	panic("unimplemented")
+	return &publicKeyRepository{
+		database: database,
+	}
 }
 
 func NewPublicKeyRepository(
@@ -54,7 +71,10 @@ func (p *publicKeyRepository) CreatePublicKey(ctx context.Context, publicKey Pub
 	_, err := p.database.
 		Insert(TabNamePublicKeys).
 		Rows(goqu.Record{
-			ColNamePublicKeysPublicKey: publicKey.PublicKey,
+			ColNamePublicKeysPublicKey:  publicKey.PublicKey,
+			ColNamePublicKeysPrivateKey: publicKey.PrivateKey,
+			ColNamePublicKeysCreatedAt:  publicKey.CreatedAt,
+			ColNamePublicKeysExpiresAt:  publicKey.ExpiresAt,
 		}).
 		Returning("id").
 		Executor().
@@ -83,3 +103,47 @@ func (p *publicKeyRepository) GetPublicKeyByID(ctx context.Context, id uint64) (
 
 	return publicKey, nil
 }
+
+// GetLatestSigningPublicKey implements PublicKeyRepository.
+func (p *publicKeyRepository) GetLatestSigningPublicKey(ctx context.Context) (PublicKey, error) {
+	publicKey := PublicKey{}
+
+	found, err := p.database.
+		From(TabNamePublicKeys).
+		Where(goqu.C(ColNamePublicKeysPrivateKey).IsNotNull()).
+		Order(goqu.C(ColNamePublicKeysID).Desc()).
+		Limit(1).
+		ScanStructContext(ctx, &publicKey)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	if !found {
+		return PublicKey{}, ErrPublicKeyNotFound
+	}
+
+	return publicKey, nil
+}
+
+// GetUnexpiredPublicKeyList implements PublicKeyRepository.
+func (p *publicKeyRepository) GetUnexpiredPublicKeyList(ctx context.Context, now time.Time) ([]PublicKey, error) {
+	publicKeyList := make([]PublicKey, 0)
+
+	err := p.database.
+		Select(ColNamePublicKeysID, ColNamePublicKeysPublicKey, ColNamePublicKeysCreatedAt, ColNamePublicKeysExpiresAt).
+		From(TabNamePublicKeys).
+		Where(goqu.C(ColNamePublicKeysExpiresAt).Gt(now)).
+		Order(goqu.C(ColNamePublicKeysID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &publicKeyList)
+	if err != nil {
+		return nil, err
+	}
+
+	return publicKeyList, nil
+}
+
+// LockPublicKeys implements PublicKeyRepository.
+func (p *publicKeyRepository) LockPublicKeys(ctx context.Context) error {
+	_, err := p.database.ExecContext(ctx, "LOCK TABLE "+TabNamePublicKeys+" IN EXCLUSIVE MODE")
+	return err
+}
@@ -0,0 +1,170 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/utils"
+)
+
+var (
+	errCreateSessionFailed = status.Error(codes.Internal, "failed to create session")
+	errUpdateSessionFailed = status.Error(codes.Internal, "failed to update session")
+	errGetSessionFailed    = status.Error(codes.Internal, "failed to get session")
+	errRevokeSessionFailed = status.Error(codes.Internal, "failed to revoke sessions of account")
+
+	ErrSessionNotFound = status.Error(codes.NotFound, "session not found")
+)
+
+const (
+	TabNameSessions                         = "sessions"
+	ColNameSessionsID                       = "id"
+	ColNameSessionsOfAccountID              = "of_account_id"
+	ColNameSessionsRefreshTokenHash         = "refresh_token_hash"
+	ColNameSessionsPreviousRefreshTokenHash = "previous_refresh_token_hash"
+	ColNameSessionsCreatedAt                = "created_at"
+	ColNameSessionsExpiresAt                = "expires_at"
+	ColNameSessionsRevokedAt                = "revoked_at"
+)
+
+// Session is a login of an account. Its refresh token is replaced every time it is used, and only its hash is
+// stored. The hash of the token it replaced is kept so that a reused refresh token can be detected.
+type Session struct {
+	ID                       uint64         `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID              uint64         `db:"of_account_id" goqu:"skipupdate"`
+	RefreshTokenHash         string         `db:"refresh_token_hash"`
+	PreviousRefreshTokenHash sql.NullString `db:"previous_refresh_token_hash"`
+	CreatedAt                time.Time      `db:"created_at" goqu:"skipinsert,skipupdate"`
+	ExpiresAt                time.Time      `db:"expires_at"`
+	RevokedAt                sql.NullTime   `db:"revoked_at"`
+}
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session Session) (uint64, error)
+	UpdateSession(ctx context.Context, session Session) error
+	// GetSessionByRefreshTokenHashWithXLock finds the session whose current or previous refresh token has the hash.
+	GetSessionByRefreshTokenHashWithXLock(ctx context.Context, refreshTokenHash string) (Session, error)
+	// RevokeSessionListOfAccount revokes every session of the account that is not revoked yet, and returns their
+	// ids.
+	RevokeSessionListOfAccount(ctx context.Context, ofAccountID uint64, revokedAt time.Time) ([]uint64, error)
+	WithDatabase(database Database) SessionRepository
+}
+
+type sessionRepository struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewSessionRepository(
+	database *goqu.Database,
+	logger *zap.Logger,
+) SessionRepository {
+	return &sessionRepository{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateSession implements SessionRepository.
+func (s *sessionRepository) CreateSession(ctx context.Context, session Session) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("of_account_id", session.OfAccountID))
+
+	var id uint64
+	_, err := s.database.
+		Insert(TabNameSessions).
+		Rows(goqu.Record{
+			ColNameSessionsOfAccountID:      session.OfAccountID,
+			ColNameSessionsRefreshTokenHash: session.RefreshTokenHash,
+			ColNameSessionsExpiresAt:        session.ExpiresAt,
+		}).
+		Returning(ColNameSessionsID).
+		Executor().
+		ScanValContext(ctx, &id)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create session")
+		return 0, errCreateSessionFailed
+	}
+
+	return id, nil
+}
+
+// UpdateSession implements SessionRepository.
+func (s *sessionRepository) UpdateSession(ctx context.Context, session Session) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", session.ID))
+
+	if _, err := s.database.
+		Update(TabNameSessions).
+		Set(session).
+		Where(goqu.C(ColNameSessionsID).Eq(session.ID)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update session")
+		return errUpdateSessionFailed
+	}
+
+	return nil
+}
+
+// GetSessionByRefreshTokenHashWithXLock implements SessionRepository.
+func (s *sessionRepository) GetSessionByRefreshTokenHashWithXLock(ctx context.Context, refreshTokenHash string) (Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	session := Session{}
+	found, err := s.database.
+		From(TabNameSessions).
+		Where(goqu.Or(
+			goqu.C(ColNameSessionsRefreshTokenHash).Eq(refreshTokenHash),
+			goqu.C(ColNameSessionsPreviousRefreshTokenHash).Eq(refreshTokenHash),
+		)).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &session)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get session by refresh token hash")
+		return Session{}, errGetSessionFailed
+	}
+	if !found {
+		return Session{}, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+// RevokeSessionListOfAccount implements SessionRepository.
+func (s *sessionRepository) RevokeSessionListOfAccount(
+	ctx context.Context,
+	ofAccountID uint64,
+	revokedAt time.Time,
+) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("of_account_id", ofAccountID))
+
+	sessionIDList := make([]uint64, 0)
+	if err := s.database.
+		Update(TabNameSessions).
+		Set(goqu.Record{ColNameSessionsRevokedAt: revokedAt}).
+		Where(
+			goqu.C(ColNameSessionsOfAccountID).Eq(ofAccountID),
+			goqu.C(ColNameSessionsRevokedAt).IsNull(),
+		).
+		Returning(ColNameSessionsID).
+		Executor().
+		ScanValsContext(ctx, &sessionIDList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to revoke sessions of account")
+		return nil, errRevokeSessionFailed
+	}
+
+	return sessionIDList, nil
+}
+
+// WithDatabase implements SessionRepository.
+func (s *sessionRepository) WithDatabase(database Database) SessionRepository {
+	return &sessionRepository{
+		database: database,
+		logger:   s.logger,
+	}
+}
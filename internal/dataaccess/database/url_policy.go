@@ -0,0 +1,244 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/generated/grpc/goload"
+	"goload/internal/utils"
+)
+
+var (
+	errCreateURLPolicyFailed  = status.Error(codes.Internal, "failed to create url policy")
+	errUpdateURLPolicyFailed  = status.Error(codes.Internal, "failed to update url policy")
+	errDeleteURLPolicyFailed  = status.Error(codes.Internal, "failed to delete url policy")
+	errGetURLPolicyFailed     = status.Error(codes.Internal, "failed to get url policy")
+	errGetURLPolicyListFailed = status.Error(codes.Internal, "failed to get url policy list")
+	errCountURLPoliciesFailed = status.Error(codes.Internal, "failed to count url policies")
+
+	ErrURLPolicyNotFound = status.Error(codes.NotFound, "url policy not found")
+)
+
+const (
+	TabNameURLPolicies            = "url_policies"
+	ColNameURLPoliciesID          = "id"
+	ColNameURLPoliciesOfAccountID = "of_account_id"
+	ColNameURLPoliciesAction      = "action"
+	ColNameURLPoliciesHostGlob    = "host_glob"
+	ColNameURLPoliciesURLRegex    = "url_regex"
+	ColNameURLPoliciesScheme      = "scheme"
+	ColNameURLPoliciesPort        = "port"
+	ColNameURLPoliciesDescription = "description"
+	ColNameURLPoliciesCreatedAt   = "created_at"
+)
+
+// URLPolicy allows or denies the download urls that meet all of its non empty conditions. It applies to every
+// account when OfAccountID is null.
+type URLPolicy struct {
+	ID          uint64                 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID sql.NullInt64          `db:"of_account_id" goqu:"skipupdate"`
+	Action      goload.UrlPolicyAction `db:"action"`
+	HostGlob    string                 `db:"host_glob"`
+	URLRegex    string                 `db:"url_regex"`
+	Scheme      string                 `db:"scheme"`
+	Port        uint32                 `db:"port"`
+	Description string                 `db:"description"`
+	CreatedAt   time.Time              `db:"created_at" goqu:"skipinsert,skipupdate"`
+}
+
+type URLPolicyRepository interface {
+	CreateURLPolicy(ctx context.Context, urlPolicy URLPolicy) (uint64, time.Time, error)
+	UpdateURLPolicy(ctx context.Context, urlPolicy URLPolicy) error
+	DeleteURLPolicy(ctx context.Context, id uint64) (bool, error)
+	GetURLPolicyByID(ctx context.Context, id uint64) (URLPolicy, error)
+	// GetURLPolicyList lists the policies of accountID, or every policy if accountID is zero.
+	GetURLPolicyList(ctx context.Context, accountID, offset, limit uint64) ([]URLPolicy, error)
+	CountURLPolicies(ctx context.Context, accountID uint64) (uint64, error)
+	// GetApplicableURLPolicyList returns the global policies along with the policies of accountID.
+	GetApplicableURLPolicyList(ctx context.Context, accountID uint64) ([]URLPolicy, error)
+	WithDatabase(database Database) URLPolicyRepository
+}
+
+type urlPolicyRepository struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewURLPolicyRepository(
+	database *goqu.Database,
+	logger *zap.Logger,
+) URLPolicyRepository {
+	return &urlPolicyRepository{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateURLPolicy implements URLPolicyRepository. It returns the ID and the creation time of the url policy.
+func (u *urlPolicyRepository) CreateURLPolicy(ctx context.Context, urlPolicy URLPolicy) (uint64, time.Time, error) {
+	logger := utils.LoggerWithContext(ctx, u.logger).With(zap.Any("url_policy", urlPolicy))
+
+	createdURLPolicy := URLPolicy{}
+	_, err := u.database.
+		Insert(TabNameURLPolicies).
+		Rows(goqu.Record{
+			ColNameURLPoliciesOfAccountID: urlPolicy.OfAccountID,
+			ColNameURLPoliciesAction:      urlPolicy.Action,
+			ColNameURLPoliciesHostGlob:    urlPolicy.HostGlob,
+			ColNameURLPoliciesURLRegex:    urlPolicy.URLRegex,
+			ColNameURLPoliciesScheme:      urlPolicy.Scheme,
+			ColNameURLPoliciesPort:        urlPolicy.Port,
+			ColNameURLPoliciesDescription: urlPolicy.Description,
+		}).
+		Returning(ColNameURLPoliciesID, ColNameURLPoliciesCreatedAt).
+		Executor().
+		ScanStructContext(ctx, &createdURLPolicy)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create url policy")
+		return 0, time.Time{}, errCreateURLPolicyFailed
+	}
+
+	return createdURLPolicy.ID, createdURLPolicy.CreatedAt, nil
+}
+
+// UpdateURLPolicy implements URLPolicyRepository.
+func (u *urlPolicyRepository) UpdateURLPolicy(ctx context.Context, urlPolicy URLPolicy) error {
+	logger := utils.LoggerWithContext(ctx, u.logger).With(zap.Uint64("id", urlPolicy.ID))
+
+	if _, err := u.database.
+		Update(TabNameURLPolicies).
+		Set(urlPolicy).
+		Where(goqu.C(ColNameURLPoliciesID).Eq(urlPolicy.ID)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update url policy")
+		return errUpdateURLPolicyFailed
+	}
+
+	return nil
+}
+
+// DeleteURLPolicy implements URLPolicyRepository. It reports false if there is no such url policy.
+func (u *urlPolicyRepository) DeleteURLPolicy(ctx context.Context, id uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, u.logger).With(zap.Uint64("id", id))
+
+	result, err := u.database.
+		Delete(TabNameURLPolicies).
+		Where(goqu.C(ColNameURLPoliciesID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete url policy")
+		return false, errDeleteURLPolicyFailed
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get rows affected")
+		return false, errDeleteURLPolicyFailed
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// GetURLPolicyByID implements URLPolicyRepository.
+func (u *urlPolicyRepository) GetURLPolicyByID(ctx context.Context, id uint64) (URLPolicy, error) {
+	logger := utils.LoggerWithContext(ctx, u.logger).With(zap.Uint64("id", id))
+
+	urlPolicy := URLPolicy{}
+	found, err := u.database.
+		From(TabNameURLPolicies).
+		Where(goqu.C(ColNameURLPoliciesID).Eq(id)).
+		ScanStructContext(ctx, &urlPolicy)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get url policy")
+		return URLPolicy{}, errGetURLPolicyFailed
+	}
+	if !found {
+		return URLPolicy{}, ErrURLPolicyNotFound
+	}
+
+	return urlPolicy, nil
+}
+
+// filterByOfAccountID keeps only the policies of accountID in dataset, unless accountID is zero.
+func (u *urlPolicyRepository) filterByOfAccountID(dataset *goqu.SelectDataset, accountID uint64) *goqu.SelectDataset {
+	if accountID == 0 {
+		return dataset
+	}
+
+	return dataset.Where(goqu.C(ColNameURLPoliciesOfAccountID).Eq(accountID))
+}
+
+// GetURLPolicyList implements URLPolicyRepository.
+func (u *urlPolicyRepository) GetURLPolicyList(ctx context.Context, accountID uint64, offset uint64, limit uint64) ([]URLPolicy, error) {
+	logger := utils.LoggerWithContext(ctx, u.logger).
+		With(zap.Uint64("account_id", accountID)).
+		With(zap.Uint64("offset", offset)).
+		With(zap.Uint64("limit", limit))
+
+	urlPolicyList := make([]URLPolicy, 0)
+	err := u.filterByOfAccountID(u.database.From(TabNameURLPolicies), accountID).
+		Order(goqu.C(ColNameURLPoliciesID).Asc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		Executor().
+		ScanStructsContext(ctx, &urlPolicyList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get url policy list")
+		return nil, errGetURLPolicyListFailed
+	}
+
+	return urlPolicyList, nil
+}
+
+// CountURLPolicies implements URLPolicyRepository.
+func (u *urlPolicyRepository) CountURLPolicies(ctx context.Context, accountID uint64) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, u.logger).With(zap.Uint64("account_id", accountID))
+
+	count, err := u.filterByOfAccountID(u.database.From(TabNameURLPolicies), accountID).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to count url policies")
+		return 0, errCountURLPoliciesFailed
+	}
+
+	return uint64(count), nil
+}
+
+// GetApplicableURLPolicyList implements URLPolicyRepository.
+func (u *urlPolicyRepository) GetApplicableURLPolicyList(ctx context.Context, accountID uint64) ([]URLPolicy, error) {
+	logger := utils.LoggerWithContext(ctx, u.logger).With(zap.Uint64("account_id", accountID))
+
+	urlPolicyList := make([]URLPolicy, 0)
+	err := u.database.
+		Select().
+		From(TabNameURLPolicies).
+		Where(goqu.Or(
+			goqu.C(ColNameURLPoliciesOfAccountID).IsNull(),
+			goqu.C(ColNameURLPoliciesOfAccountID).Eq(accountID),
+		)).
+		Order(goqu.C(ColNameURLPoliciesID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &urlPolicyList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get applicable url policy list")
+		return nil, errGetURLPolicyListFailed
+	}
+
+	return urlPolicyList, nil
+}
+
+// WithDatabase implements URLPolicyRepository.
+func (u *urlPolicyRepository) WithDatabase(database Database) URLPolicyRepository {
+	return &urlPolicyRepository{
+		database: database,
+		logger:   u.logger,
+	}
+}
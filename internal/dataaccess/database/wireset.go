@@ -12,4 +12,9 @@ var WireSet = wire.NewSet(
 	NewAccountPasswordRepository,
 	NewPublicKeyRepository,
 	NewDownloadRepository,
+	NewOutboxMessageRepository,
+	NewSessionRepository,
+	NewApiKeyRepository,
+	NewPasswordResetTokenRepository,
+	NewURLPolicyRepository,
 )
@@ -7,11 +7,24 @@ import (
 	"io"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrAppendUnsupported = status.Error(codes.FailedPrecondition, "file can not be appended from the requested offset")
 )
 
 type Client interface {
 	Write(ctx context.Context, filePath string) (io.WriteCloser, error)
+	// Append keeps the first offset bytes of filePath and returns a writer positioned right after them.
+	// ErrAppendUnsupported is returned when the existing file can not be resumed from offset.
+	Append(ctx context.Context, filePath string, offset int64) (io.WriteCloser, error)
+	// Compose writes the files of partFilePathList one after another into filePath, then deletes them.
+	Compose(ctx context.Context, filePath string, partFilePathList []string) error
 	Read(ctx context.Context, filePath string) (io.ReadCloser, error)
+	// Delete removes filePath, deleting a file that does not exist is not an error.
+	Delete(ctx context.Context, filePath string) error
 }
 
 func NewClient(
@@ -21,6 +34,15 @@ func NewClient(
 	switch downloadConfig.Mode {
 	case configs.DownloadModeLocal:
 		return newLocalClient(downloadConfig.DownloadDirectory, logger)
+	case configs.DownloadModeS3:
+		return newS3Client(
+			downloadConfig.Address,
+			downloadConfig.Bucket,
+			downloadConfig.Username,
+			downloadConfig.Password,
+			downloadConfig.UseSSL,
+			logger,
+		)
 	default:
 		return nil, fmt.Errorf("download mode is unsupported: %s", downloadConfig.Mode)
 	}
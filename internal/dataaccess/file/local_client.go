@@ -16,7 +16,9 @@ import (
 )
 
 var (
-	errOpenFileFailed = status.Error(codes.Internal, "failed to open file")
+	errOpenFileFailed    = status.Error(codes.Internal, "failed to open file")
+	errDeleteFileFailed  = status.Error(codes.Internal, "failed to delete file")
+	errComposeFileFailed = status.Error(codes.Internal, "failed to compose file")
 )
 
 type localClient struct {
@@ -54,6 +56,46 @@ func (l *localClient) Read(ctx context.Context, filePath string) (io.ReadCloser,
 	return newBufferedFileReader(file), nil
 }
 
+// Append implements Client.
+func (l *localClient) Append(ctx context.Context, filePath string, offset int64) (io.WriteCloser, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).
+		With(zap.String("file_path", filePath)).
+		With(zap.Int64("offset", offset))
+
+	absolutePath := path.Join(l.downloadDirectory, filePath)
+	file, err := os.OpenFile(absolutePath, os.O_WRONLY|os.O_CREATE, 0o644)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to open file")
+		return nil, errOpenFileFailed
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		file.Close()
+		logger.With(zap.Error(err)).Error("failed to stat file")
+		return nil, errOpenFileFailed
+	}
+
+	if fileInfo.Size() < offset {
+		file.Close()
+		return nil, ErrAppendUnsupported
+	}
+
+	if err = file.Truncate(offset); err != nil {
+		file.Close()
+		logger.With(zap.Error(err)).Error("failed to truncate file")
+		return nil, errOpenFileFailed
+	}
+
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		logger.With(zap.Error(err)).Error("failed to seek file")
+		return nil, errOpenFileFailed
+	}
+
+	return file, nil
+}
+
 // Write implements Client.
 func (l *localClient) Write(ctx context.Context, filePath string) (io.WriteCloser, error) {
 	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("file_path", filePath))
@@ -68,3 +110,60 @@ func (l *localClient) Write(ctx context.Context, filePath string) (io.WriteClose
 
 	return file, nil
 }
+
+// Compose implements Client.
+func (l *localClient) Compose(ctx context.Context, filePath string, partFilePathList []string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("file_path", filePath))
+
+	absolutePath := path.Join(l.downloadDirectory, filePath)
+	file, err := os.Create(absolutePath)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to open file")
+		return errOpenFileFailed
+	}
+
+	for _, partFilePath := range partFilePathList {
+		if err = l.copyFile(file, partFilePath); err != nil {
+			file.Close()
+			logger.With(zap.Error(err)).With(zap.String("part_file_path", partFilePath)).Error("failed to compose file")
+			return errComposeFileFailed
+		}
+	}
+
+	if err = file.Close(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to close composed file")
+		return errComposeFileFailed
+	}
+
+	for _, partFilePath := range partFilePathList {
+		if err = os.Remove(path.Join(l.downloadDirectory, partFilePath)); err != nil {
+			logger.With(zap.Error(err)).With(zap.String("part_file_path", partFilePath)).Warn("failed to delete part file")
+		}
+	}
+
+	return nil
+}
+
+func (l *localClient) copyFile(writer io.Writer, filePath string) error {
+	file, err := os.Open(path.Join(l.downloadDirectory, filePath))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(writer, file)
+	return err
+}
+
+// Delete implements Client.
+func (l *localClient) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("file_path", filePath))
+
+	absolutePath := path.Join(l.downloadDirectory, filePath)
+	if err := os.Remove(absolutePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.With(zap.Error(err)).Error("failed to delete file")
+		return errDeleteFileFailed
+	}
+
+	return nil
+}
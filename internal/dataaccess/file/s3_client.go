@@ -0,0 +1,328 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/utils"
+)
+
+const (
+	s3MultipartUploadPartSizeInBytes = 16 * 1024 * 1024
+	// S3 requires every part of a composed object except the last one to be at least 5MiB.
+	s3ComposeMinPartSizeInBytes = 5 * 1024 * 1024
+	s3AppendTempObjectSuffix    = ".append"
+)
+
+var (
+	errGetObjectFailed     = status.Error(codes.Internal, "failed to get object from s3")
+	errPutObjectFailed     = status.Error(codes.Internal, "failed to put object into s3")
+	errObjectNotFound      = status.Error(codes.NotFound, "object not found in s3")
+	errUploadAlreadyEnded  = status.Error(codes.Internal, "s3 upload is already ended")
+	errComposeObjectFailed = status.Error(codes.Internal, "failed to compose object in s3")
+	errRemoveObjectFailed  = status.Error(codes.Internal, "failed to remove object from s3")
+)
+
+type s3UploadWriteCloser struct {
+	pipeWriter *io.PipeWriter
+	uploadDone chan error
+	closed     bool
+}
+
+func newS3UploadWriteCloser(pipeWriter *io.PipeWriter, uploadDone chan error) io.WriteCloser {
+	return &s3UploadWriteCloser{
+		pipeWriter: pipeWriter,
+		uploadDone: uploadDone,
+	}
+}
+
+func (s *s3UploadWriteCloser) Write(p []byte) (int, error) {
+	return s.pipeWriter.Write(p)
+}
+
+// Close flushes the remaining bytes to s3 and waits for the multipart upload to complete.
+func (s *s3UploadWriteCloser) Close() error {
+	if s.closed {
+		return errUploadAlreadyEnded
+	}
+	s.closed = true
+
+	if err := s.pipeWriter.Close(); err != nil {
+		return err
+	}
+
+	return <-s.uploadDone
+}
+
+type s3ComposeWriteCloser struct {
+	io.WriteCloser
+	compose func() error
+}
+
+// Close finishes uploading the appended bytes, then stitches them onto the existing object.
+func (s s3ComposeWriteCloser) Close() error {
+	if err := s.WriteCloser.Close(); err != nil {
+		return err
+	}
+
+	return s.compose()
+}
+
+type s3Client struct {
+	minioClient *minio.Client
+	bucket      string
+	logger      *zap.Logger
+}
+
+func newS3Client(
+	address string,
+	bucket string,
+	username string,
+	password string,
+	useSSL bool,
+	logger *zap.Logger,
+) (Client, error) {
+	minioClient, err := minio.New(address, &minio.Options{
+		Creds:  credentials.NewStaticV4(username, password, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	bucketExists, err := minioClient.BucketExists(context.Background(), bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if bucket exists: %w", err)
+	}
+
+	if !bucketExists {
+		if err = minioClient.MakeBucket(context.Background(), bucket, minio.MakeBucketOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to create bucket: %w", err)
+		}
+	}
+
+	return &s3Client{
+		minioClient: minioClient,
+		bucket:      bucket,
+		logger:      logger,
+	}, nil
+}
+
+// Read implements Client.
+func (s *s3Client) Read(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("file_path", filePath))
+
+	object, err := s.minioClient.GetObject(ctx, s.bucket, filePath, minio.GetObjectOptions{})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get object")
+		return nil, errGetObjectFailed
+	}
+
+	// GetObject is lazy, stat the object so that a missing file is reported here instead of on the first read.
+	if _, err = object.Stat(); err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, errObjectNotFound
+		}
+
+		logger.With(zap.Error(err)).Error("failed to stat object")
+		return nil, errGetObjectFailed
+	}
+
+	return object, nil
+}
+
+// Append implements Client.
+func (s *s3Client) Append(ctx context.Context, filePath string, offset int64) (io.WriteCloser, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).
+		With(zap.String("file_path", filePath)).
+		With(zap.Int64("offset", offset))
+
+	if offset == 0 {
+		return s.Write(ctx, filePath)
+	}
+
+	if offset < s3ComposeMinPartSizeInBytes {
+		return nil, ErrAppendUnsupported
+	}
+
+	objectInfo, err := s.minioClient.StatObject(ctx, s.bucket, filePath, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrAppendUnsupported
+		}
+
+		logger.With(zap.Error(err)).Error("failed to stat object")
+		return nil, errGetObjectFailed
+	}
+
+	if objectInfo.Size < offset {
+		return nil, ErrAppendUnsupported
+	}
+
+	tempFilePath := filePath + s3AppendTempObjectSuffix
+	tempWriteCloser, err := s.Write(ctx, tempFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return s3ComposeWriteCloser{
+		WriteCloser: tempWriteCloser,
+		compose: func() error {
+			defer func() {
+				if err := s.minioClient.RemoveObject(ctx, s.bucket, tempFilePath, minio.RemoveObjectOptions{}); err != nil {
+					logger.With(zap.Error(err)).Warn("failed to remove temporary append object")
+				}
+			}()
+
+			_, err := s.minioClient.ComposeObject(
+				ctx,
+				minio.CopyDestOptions{Bucket: s.bucket, Object: filePath},
+				minio.CopySrcOptions{Bucket: s.bucket, Object: filePath, MatchRange: true, Start: 0, End: offset - 1},
+				minio.CopySrcOptions{Bucket: s.bucket, Object: tempFilePath},
+			)
+			if err != nil {
+				logger.With(zap.Error(err)).Error("failed to compose appended object")
+				return errComposeObjectFailed
+			}
+
+			return nil
+		},
+	}, nil
+}
+
+// Write implements Client.
+func (s *s3Client) Write(ctx context.Context, filePath string) (io.WriteCloser, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("file_path", filePath))
+
+	pipeReader, pipeWriter := io.Pipe()
+	uploadDone := make(chan error, 1)
+
+	go func() {
+		_, err := s.minioClient.PutObject(ctx, s.bucket, filePath, pipeReader, -1, minio.PutObjectOptions{
+			PartSize: s3MultipartUploadPartSizeInBytes,
+		})
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to put object")
+			pipeReader.CloseWithError(err)
+			uploadDone <- errPutObjectFailed
+			return
+		}
+
+		uploadDone <- nil
+	}()
+
+	return newS3UploadWriteCloser(pipeWriter, uploadDone), nil
+}
+
+// Compose implements Client. S3 only composes objects whose parts, except the last one, are at least 5MiB, so
+// smaller parts are streamed from s3 back into the new object instead.
+func (s *s3Client) Compose(ctx context.Context, filePath string, partFilePathList []string) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).
+		With(zap.String("file_path", filePath)).
+		With(zap.Int("part_count", len(partFilePathList)))
+
+	var (
+		totalSize  int64
+		composable = len(partFilePathList) > 0
+	)
+	for i, partFilePath := range partFilePathList {
+		objectInfo, err := s.minioClient.StatObject(ctx, s.bucket, partFilePath, minio.StatObjectOptions{})
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+				return errObjectNotFound
+			}
+
+			logger.With(zap.Error(err)).With(zap.String("part_file_path", partFilePath)).Error("failed to stat object")
+			return errGetObjectFailed
+		}
+
+		totalSize += objectInfo.Size
+		if i < len(partFilePathList)-1 && objectInfo.Size < s3ComposeMinPartSizeInBytes {
+			composable = false
+		}
+	}
+
+	var err error
+	if composable {
+		err = s.composeObject(ctx, filePath, partFilePathList)
+	} else {
+		err = s.concatenateObjects(ctx, filePath, partFilePathList, totalSize)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, partFilePath := range partFilePathList {
+		if err = s.minioClient.RemoveObject(ctx, s.bucket, partFilePath, minio.RemoveObjectOptions{}); err != nil {
+			logger.With(zap.Error(err)).With(zap.String("part_file_path", partFilePath)).Warn("failed to remove part object")
+		}
+	}
+
+	return nil
+}
+
+func (s *s3Client) composeObject(ctx context.Context, filePath string, partFilePathList []string) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("file_path", filePath))
+
+	srcList := make([]minio.CopySrcOptions, 0, len(partFilePathList))
+	for _, partFilePath := range partFilePathList {
+		srcList = append(srcList, minio.CopySrcOptions{Bucket: s.bucket, Object: partFilePath})
+	}
+
+	if _, err := s.minioClient.ComposeObject(ctx, minio.CopyDestOptions{Bucket: s.bucket, Object: filePath}, srcList...); err != nil {
+		logger.With(zap.Error(err)).Error("failed to compose object")
+		return errComposeObjectFailed
+	}
+
+	return nil
+}
+
+func (s *s3Client) concatenateObjects(ctx context.Context, filePath string, partFilePathList []string, totalSize int64) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("file_path", filePath))
+
+	readerList := make([]io.Reader, 0, len(partFilePathList))
+	for _, partFilePath := range partFilePathList {
+		// GetObject is lazy, each part is only requested once the previous ones have been read.
+		object, err := s.minioClient.GetObject(ctx, s.bucket, partFilePath, minio.GetObjectOptions{})
+		if err != nil {
+			logger.With(zap.Error(err)).With(zap.String("part_file_path", partFilePath)).Error("failed to get object")
+			return errGetObjectFailed
+		}
+		defer object.Close()
+
+		readerList = append(readerList, object)
+	}
+
+	_, err := s.minioClient.PutObject(ctx, s.bucket, filePath, io.MultiReader(readerList...), totalSize, minio.PutObjectOptions{
+		PartSize: s3MultipartUploadPartSizeInBytes,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to put concatenated object")
+		return errComposeObjectFailed
+	}
+
+	return nil
+}
+
+// Delete implements Client.
+func (s *s3Client) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("file_path", filePath))
+
+	// An interrupted Append may have left its temporary object behind as well.
+	for _, objectName := range []string{filePath, filePath + s3AppendTempObjectSuffix} {
+		if err := s.minioClient.RemoveObject(ctx, s.bucket, objectName, minio.RemoveObjectOptions{}); err != nil {
+			logger.With(zap.Error(err)).Error("failed to remove object")
+			return errRemoveObjectFailed
+		}
+	}
+
+	return nil
+}
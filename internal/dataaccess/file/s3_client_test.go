@@ -0,0 +1,573 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const testS3Bucket = "goload"
+
+// fakeS3Server implements the part of the S3 API that s3Client uses, keeping every object in memory.
+type fakeS3Server struct {
+	mutex          sync.Mutex
+	bucketMap      map[string]bool
+	objectMap      map[string][]byte
+	uploadMap      map[string]map[int][]byte
+	uploadCount    int
+	copyCount      int
+	lastModifiedAt time.Time
+}
+
+func newFakeS3Server() *fakeS3Server {
+	return &fakeS3Server{
+		bucketMap:      make(map[string]bool),
+		objectMap:      make(map[string][]byte),
+		uploadMap:      make(map[string]map[int][]byte),
+		lastModifiedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (f *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The body is read before locking, an upload may be streaming objects that are read from this server.
+	body, err := f.readBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	bucket, object, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+	key := bucket + "/" + object
+
+	switch {
+	case object == "":
+		f.serveBucket(w, r, bucket)
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		f.uploadCount++
+		uploadID := strconv.Itoa(f.uploadCount)
+		f.uploadMap[uploadID] = make(map[int][]byte)
+		f.writeXML(w, http.StatusOK, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: object, UploadId: uploadID})
+	case query.Has("uploadId"):
+		f.serveMultipartUpload(w, r, bucket, object, body)
+	case r.Method == http.MethodPut:
+		if copySource := r.Header.Get("X-Amz-Copy-Source"); copySource != "" {
+			data, ok := f.getCopySource(copySource, "")
+			if !ok {
+				f.writeNoSuchKey(w, object)
+				return
+			}
+
+			f.copyCount++
+			f.objectMap[key] = data
+			f.writeXML(w, http.StatusOK, struct {
+				XMLName      xml.Name `xml:"CopyObjectResult"`
+				ETag         string
+				LastModified string
+			}{ETag: f.getETag(data), LastModified: f.lastModifiedAt.Format(time.RFC3339)})
+			return
+		}
+
+		f.objectMap[key] = body
+		w.Header().Set("ETag", f.getETag(body))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		data, ok := f.objectMap[key]
+		if !ok {
+			f.writeNoSuchKey(w, object)
+			return
+		}
+
+		w.Header().Set("ETag", f.getETag(data))
+		w.Header().Set("Last-Modified", f.lastModifiedAt.Format(http.TimeFormat))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		statusCode := http.StatusOK
+		if start, end, ok := f.parseRange(r.Header.Get("Range"), int64(len(data))); ok {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+			data = data[start : end+1]
+			statusCode = http.StatusPartialContent
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(statusCode)
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(f.objectMap, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeS3Server) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	switch {
+	case r.URL.Query().Has("location"):
+		f.writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"LocationConstraint"`
+		}{})
+	case r.Method == http.MethodHead:
+		if !f.bucketMap[bucket] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPut:
+		f.bucketMap[bucket] = true
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeS3Server) serveMultipartUpload(w http.ResponseWriter, r *http.Request, bucket string, object string, body []byte) {
+	key := bucket + "/" + object
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+	partMap, ok := f.uploadMap[uploadID]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		partNumber, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if copySource := r.Header.Get("X-Amz-Copy-Source"); copySource != "" {
+			data, ok := f.getCopySource(copySource, r.Header.Get("X-Amz-Copy-Source-Range"))
+			if !ok {
+				f.writeNoSuchKey(w, copySource)
+				return
+			}
+
+			f.copyCount++
+			partMap[partNumber] = data
+			f.writeXML(w, http.StatusOK, struct {
+				XMLName      xml.Name `xml:"CopyPartResult"`
+				ETag         string
+				LastModified string
+			}{ETag: f.getETag(data), LastModified: f.lastModifiedAt.Format(time.RFC3339)})
+			return
+		}
+
+		partMap[partNumber] = body
+		w.Header().Set("ETag", f.getETag(body))
+		w.WriteHeader(http.StatusOK)
+	case http.MethodPost:
+		var request struct {
+			Parts []struct {
+				PartNumber int
+			} `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sort.Slice(request.Parts, func(i, j int) bool {
+			return request.Parts[i].PartNumber < request.Parts[j].PartNumber
+		})
+		var data []byte
+		for _, part := range request.Parts {
+			data = append(data, partMap[part.PartNumber]...)
+		}
+
+		f.objectMap[key] = data
+		delete(f.uploadMap, uploadID)
+		f.writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: object, ETag: f.getETag(data)})
+	case http.MethodDelete:
+		delete(f.uploadMap, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeS3Server) getCopySource(copySource string, copySourceRange string) ([]byte, bool) {
+	sourceKey, err := url.PathUnescape(copySource)
+	if err != nil {
+		return nil, false
+	}
+
+	data, ok := f.objectMap[strings.TrimPrefix(sourceKey, "/")]
+	if !ok {
+		return nil, false
+	}
+
+	if start, end, ok := f.parseRange(copySourceRange, int64(len(data))); ok {
+		data = data[start : end+1]
+	}
+
+	return bytes.Clone(data), true
+}
+
+func (f *fakeS3Server) parseRange(rangeHeader string, size int64) (int64, int64, bool) {
+	startText, endText, ok := strings.Cut(strings.TrimPrefix(rangeHeader, "bytes="), "-")
+	if !ok {
+		return 0, 0, false
+	}
+
+	start, err := strconv.ParseInt(startText, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	end := size - 1
+	if endText != "" {
+		if end, err = strconv.ParseInt(endText, 10, 64); err != nil {
+			return 0, 0, false
+		}
+	}
+
+	return start, min(end, size-1), true
+}
+
+// readBody decodes the aws-chunked encoding that minio uses to sign streamed uploads over plain http.
+func (f *fakeS3Server) readBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return body, nil
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(body))
+	data := make([]byte, 0, len(body))
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		sizeText, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeText, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return data, nil
+		}
+
+		chunk := make([]byte, size+2)
+		if _, err = io.ReadFull(reader, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+func (f *fakeS3Server) getETag(data []byte) string {
+	digest := md5.Sum(data)
+	return `"` + hex.EncodeToString(digest[:]) + `"`
+}
+
+func (f *fakeS3Server) writeNoSuchKey(w http.ResponseWriter, object string) {
+	f.writeXML(w, http.StatusNotFound, struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+		Key     string
+	}{Code: "NoSuchKey", Message: "The specified key does not exist.", Key: object})
+}
+
+func (f *fakeS3Server) writeXML(w http.ResponseWriter, statusCode int, value any) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	xml.NewEncoder(w).Encode(value)
+}
+
+func (f *fakeS3Server) getObject(filePath string) ([]byte, bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	data, ok := f.objectMap[testS3Bucket+"/"+filePath]
+	return data, ok
+}
+
+func newTestS3Client(t *testing.T) (Client, *fakeS3Server) {
+	t.Helper()
+
+	fakeServer := newFakeS3Server()
+	server := httptest.NewServer(fakeServer)
+	t.Cleanup(server.Close)
+
+	client, err := newS3Client(strings.TrimPrefix(server.URL, "http://"), testS3Bucket, "access", "secret", false, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create s3 client: %v", err)
+	}
+
+	return client, fakeServer
+}
+
+func newTestData(size int, seed byte) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = seed + byte(i%251)
+	}
+
+	return data
+}
+
+func writeTestFile(t *testing.T, client Client, filePath string, data []byte) {
+	t.Helper()
+
+	writeCloser, err := client.Write(context.Background(), filePath)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", filePath, err)
+	}
+
+	if _, err = writeCloser.Write(data); err != nil {
+		t.Fatalf("failed to write %s: %v", filePath, err)
+	}
+
+	if err = writeCloser.Close(); err != nil {
+		t.Fatalf("failed to close %s: %v", filePath, err)
+	}
+}
+
+func readTestFile(t *testing.T, client Client, filePath string) []byte {
+	t.Helper()
+
+	readCloser, err := client.Read(context.Background(), filePath)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", filePath, err)
+	}
+	defer readCloser.Close()
+
+	data, err := io.ReadAll(readCloser)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", filePath, err)
+	}
+
+	return data
+}
+
+func TestS3ClientWriteAndRead(t *testing.T) {
+	client, _ := newTestS3Client(t)
+
+	testCases := []struct {
+		name string
+		size int
+	}{
+		{name: "empty", size: 0},
+		{name: "single part", size: 1024},
+		{name: "multipart", size: s3MultipartUploadPartSizeInBytes + 1024},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			data := newTestData(testCase.size, 1)
+			writeTestFile(t, client, testCase.name, data)
+
+			if got := readTestFile(t, client, testCase.name); !bytes.Equal(got, data) {
+				t.Fatalf("read %d bytes, expected the %d written bytes", len(got), len(data))
+			}
+		})
+	}
+
+	if _, err := client.Read(context.Background(), "missing"); !errors.Is(err, errObjectNotFound) {
+		t.Fatalf("reading a missing file returned %v, expected %v", err, errObjectNotFound)
+	}
+}
+
+func TestS3ClientAppend(t *testing.T) {
+	existingData := newTestData(s3ComposeMinPartSizeInBytes+1024, 1)
+	appendedData := newTestData(2048, 7)
+
+	testCases := []struct {
+		name         string
+		existingData []byte
+		offset       int64
+		expectedErr  error
+		expectedData []byte
+	}{
+		{
+			name:         "from the beginning",
+			existingData: existingData,
+			offset:       0,
+			expectedData: appendedData,
+		},
+		{
+			name:         "after the existing bytes",
+			existingData: existingData,
+			offset:       s3ComposeMinPartSizeInBytes,
+			expectedData: append(bytes.Clone(existingData[:s3ComposeMinPartSizeInBytes]), appendedData...),
+		},
+		{
+			name:         "offset below the compose minimum",
+			existingData: existingData,
+			offset:       s3ComposeMinPartSizeInBytes - 1,
+			expectedErr:  ErrAppendUnsupported,
+		},
+		{
+			name:         "offset past the existing bytes",
+			existingData: existingData[:s3ComposeMinPartSizeInBytes],
+			offset:       s3ComposeMinPartSizeInBytes + 1,
+			expectedErr:  ErrAppendUnsupported,
+		},
+		{
+			name:        "missing file",
+			offset:      s3ComposeMinPartSizeInBytes,
+			expectedErr: ErrAppendUnsupported,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client, fakeServer := newTestS3Client(t)
+			if testCase.existingData != nil {
+				writeTestFile(t, client, "file", testCase.existingData)
+			}
+
+			writeCloser, err := client.Append(context.Background(), "file", testCase.offset)
+			if testCase.expectedErr != nil {
+				if !errors.Is(err, testCase.expectedErr) {
+					t.Fatalf("append returned %v, expected %v", err, testCase.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to append: %v", err)
+			}
+
+			if _, err = writeCloser.Write(appendedData); err != nil {
+				t.Fatalf("failed to write appended bytes: %v", err)
+			}
+			if err = writeCloser.Close(); err != nil {
+				t.Fatalf("failed to close appended file: %v", err)
+			}
+
+			if got := readTestFile(t, client, "file"); !bytes.Equal(got, testCase.expectedData) {
+				t.Fatalf("read %d bytes, expected %d bytes", len(got), len(testCase.expectedData))
+			}
+			if _, ok := fakeServer.getObject("file" + s3AppendTempObjectSuffix); ok {
+				t.Fatal("temporary append object is left behind")
+			}
+		})
+	}
+}
+
+func TestS3ClientCompose(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		partSizeList         []int
+		expectServerSideCopy bool
+	}{
+		{
+			name:                 "parts large enough to compose in s3",
+			partSizeList:         []int{s3ComposeMinPartSizeInBytes, s3ComposeMinPartSizeInBytes + 1, 1024},
+			expectServerSideCopy: true,
+		},
+		{
+			name:                 "single part",
+			partSizeList:         []int{1024},
+			expectServerSideCopy: true,
+		},
+		{
+			name:                 "small parts are concatenated",
+			partSizeList:         []int{1024, s3ComposeMinPartSizeInBytes, 2048},
+			expectServerSideCopy: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client, fakeServer := newTestS3Client(t)
+
+			var (
+				partFilePathList []string
+				expectedData     []byte
+			)
+			for i, partSize := range testCase.partSizeList {
+				partFilePath := fmt.Sprintf("file.part%d", i)
+				partData := newTestData(partSize, byte(i))
+				writeTestFile(t, client, partFilePath, partData)
+				partFilePathList = append(partFilePathList, partFilePath)
+				expectedData = append(expectedData, partData...)
+			}
+
+			if err := client.Compose(context.Background(), "file", partFilePathList); err != nil {
+				t.Fatalf("failed to compose: %v", err)
+			}
+
+			if got := readTestFile(t, client, "file"); !bytes.Equal(got, expectedData) {
+				t.Fatalf("read %d bytes, expected %d bytes", len(got), len(expectedData))
+			}
+			if serverSideCopy := fakeServer.copyCount > 0; serverSideCopy != testCase.expectServerSideCopy {
+				t.Fatalf("copied parts in s3: %t, expected %t", serverSideCopy, testCase.expectServerSideCopy)
+			}
+			for _, partFilePath := range partFilePathList {
+				if _, ok := fakeServer.getObject(partFilePath); ok {
+					t.Fatalf("part %s is left behind", partFilePath)
+				}
+			}
+		})
+	}
+
+	t.Run("missing part", func(t *testing.T) {
+		client, _ := newTestS3Client(t)
+		writeTestFile(t, client, "file.part0", newTestData(1024, 0))
+
+		err := client.Compose(context.Background(), "file", []string{"file.part0", "file.part1"})
+		if !errors.Is(err, errObjectNotFound) {
+			t.Fatalf("compose returned %v, expected %v", err, errObjectNotFound)
+		}
+	})
+}
+
+func TestS3ClientDelete(t *testing.T) {
+	client, fakeServer := newTestS3Client(t)
+	writeTestFile(t, client, "file", newTestData(1024, 0))
+	writeTestFile(t, client, "file"+s3AppendTempObjectSuffix, newTestData(1024, 1))
+
+	for _, filePath := range []string{"file", "missing"} {
+		if err := client.Delete(context.Background(), filePath); err != nil {
+			t.Fatalf("failed to delete %s: %v", filePath, err)
+		}
+	}
+
+	for _, filePath := range []string{"file", "file" + s3AppendTempObjectSuffix} {
+		if _, ok := fakeServer.getObject(filePath); ok {
+			t.Fatalf("%s is not deleted", filePath)
+		}
+	}
+}
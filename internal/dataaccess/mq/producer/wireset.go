@@ -4,5 +4,4 @@ import "github.com/google/wire"
 
 var Wireset = wire.NewSet(
 	NewClient,
-	NewDownloadTaskCreatedProducer,
 )
@@ -0,0 +1,69 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/utils"
+)
+
+var (
+	errNotifyFailed = status.Error(codes.Internal, "failed to send notification")
+)
+
+// fileNotifier appends every notification to a file as a line of JSON.
+type fileNotifier struct {
+	filePath  string
+	fileMutex *sync.Mutex
+	logger    *zap.Logger
+}
+
+func newFileNotifier(filePath string, logger *zap.Logger) (Notifier, error) {
+	if filePath == "" {
+		return nil, errors.New("file path of file notifier is empty")
+	}
+
+	return &fileNotifier{
+		filePath:  filePath,
+		fileMutex: new(sync.Mutex),
+		logger:    logger,
+	}, nil
+}
+
+// Notify implements Notifier.
+func (f fileNotifier) Notify(ctx context.Context, notification Notification) error {
+	logger := utils.LoggerWithContext(ctx, f.logger).
+		With(zap.String("file_path", f.filePath)).
+		With(zap.Uint64("account_id", notification.AccountID))
+
+	notificationBytes, err := json.Marshal(notification)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal notification")
+		return errNotifyFailed
+	}
+
+	f.fileMutex.Lock()
+	defer f.fileMutex.Unlock()
+
+	// Notifications may carry secrets, so the file is only readable by its owner.
+	file, err := os.OpenFile(f.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to open notification file")
+		return errNotifyFailed
+	}
+	defer file.Close()
+
+	if _, err = file.Write(append(notificationBytes, '\n')); err != nil {
+		logger.With(zap.Error(err)).Error("failed to write notification file")
+		return errNotifyFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package notifier
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"goload/internal/utils"
+)
+
+// logNotifier writes notifications to the log, it must only be used locally since they may contain secrets.
+type logNotifier struct {
+	logger *zap.Logger
+}
+
+func newLogNotifier(logger *zap.Logger) Notifier {
+	return &logNotifier{
+		logger: logger,
+	}
+}
+
+// Notify implements Notifier.
+func (l logNotifier) Notify(ctx context.Context, notification Notification) error {
+	utils.LoggerWithContext(ctx, l.logger).
+		With(zap.Uint64("account_id", notification.AccountID)).
+		With(zap.String("account_name", notification.AccountName)).
+		With(zap.String("subject", notification.Subject)).
+		With(zap.String("body", notification.Body)).
+		Info("notification")
+	return nil
+}
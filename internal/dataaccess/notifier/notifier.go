@@ -0,0 +1,36 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"goload/internal/configs"
+)
+
+// Notification is a message for the owner of an account.
+type Notification struct {
+	AccountID   uint64 `json:"account_id"`
+	AccountName string `json:"account_name"`
+	Subject     string `json:"subject"`
+	Body        string `json:"body"`
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+func NewNotifier(
+	notifierConfig configs.Notifier,
+	logger *zap.Logger,
+) (Notifier, error) {
+	switch notifierConfig.Type {
+	case configs.NotifierTypeLog:
+		return newLogNotifier(logger), nil
+	case configs.NotifierTypeFile:
+		return newFileNotifier(notifierConfig.FilePath, logger)
+	default:
+		return nil, fmt.Errorf("notifier type is unsupported: %s", notifierConfig.Type)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"goload/internal/dataaccess/database"
 	"goload/internal/dataaccess/file"
 	"goload/internal/dataaccess/mq"
+	"goload/internal/dataaccess/notifier"
 )
 
 var WireSet = wire.NewSet(
@@ -14,4 +15,5 @@ var WireSet = wire.NewSet(
 	mq.WireSet,
 	file.WireSet,
 	cache.WireSet,
+	notifier.WireSet,
 )
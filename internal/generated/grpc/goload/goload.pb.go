@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -77,6 +78,8 @@ const (
 	DownloadStatus_Downloading     DownloadStatus = 2
 	DownloadStatus_Failed          DownloadStatus = 3
 	DownloadStatus_Success         DownloadStatus = 4
+	DownloadStatus_Canceled        DownloadStatus = 5
+	DownloadStatus_Paused          DownloadStatus = 6
 )
 
 // Enum value maps for DownloadStatus.
@@ -87,6 +90,8 @@ var (
 		2: "Downloading",
 		3: "Failed",
 		4: "Success",
+		5: "Canceled",
+		6: "Paused",
 	}
 	DownloadStatus_value = map[string]int32{
 		"UndefinedStatus": 0,
@@ -94,6 +99,8 @@ var (
 		"Downloading":     2,
 		"Failed":          3,
 		"Success":         4,
+		"Canceled":        5,
+		"Paused":          6,
 	}
 )
 
@@ -124,29 +131,2840 @@ func (DownloadStatus) EnumDescriptor() ([]byte, []int) {
 	return file_goload_proto_rawDescGZIP(), []int{1}
 }
 
+// ApiKeyScope is what an api key is allowed to do. Api keys can never manage accounts, sessions or other api keys.
+type ApiKeyScope int32
+
+const (
+	ApiKeyScope_UndefinedApiKeyScope ApiKeyScope = 0
+	// Get, list, watch and download the files of download tasks.
+	ApiKeyScope_ReadDownloadTasks ApiKeyScope = 1
+	// Create, update, delete, cancel, pause and resume download tasks.
+	ApiKeyScope_WriteDownloadTasks ApiKeyScope = 2
+)
+
+// Enum value maps for ApiKeyScope.
+var (
+	ApiKeyScope_name = map[int32]string{
+		0: "UndefinedApiKeyScope",
+		1: "ReadDownloadTasks",
+		2: "WriteDownloadTasks",
+	}
+	ApiKeyScope_value = map[string]int32{
+		"UndefinedApiKeyScope": 0,
+		"ReadDownloadTasks":    1,
+		"WriteDownloadTasks":   2,
+	}
+)
+
+func (x ApiKeyScope) Enum() *ApiKeyScope {
+	p := new(ApiKeyScope)
+	*p = x
+	return p
+}
+
+func (x ApiKeyScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiKeyScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_goload_proto_enumTypes[2].Descriptor()
+}
+
+func (ApiKeyScope) Type() protoreflect.EnumType {
+	return &file_goload_proto_enumTypes[2]
+}
+
+func (x ApiKeyScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiKeyScope.Descriptor instead.
+func (ApiKeyScope) EnumDescriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{2}
+}
+
+type Role int32
+
+const (
+	Role_UndefinedRole Role = 0
+	// Manages its own download tasks, api keys and sessions.
+	Role_User Role = 1
+	// Can also see and manage the download tasks of every account.
+	Role_Operator Role = 2
+	// Can also manage accounts.
+	Role_Admin Role = 3
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "UndefinedRole",
+		1: "User",
+		2: "Operator",
+		3: "Admin",
+	}
+	Role_value = map[string]int32{
+		"UndefinedRole": 0,
+		"User":          1,
+		"Operator":      2,
+		"Admin":         3,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_goload_proto_enumTypes[3].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_goload_proto_enumTypes[3]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{3}
+}
+
+// UrlPolicyAction is what happens to the download urls that a url policy matches. A url matched by any deny policy
+// is rejected. Once an account has allow policies, global or its own, its urls must also match one of them.
+type UrlPolicyAction int32
+
+const (
+	UrlPolicyAction_UndefinedUrlPolicyAction UrlPolicyAction = 0
+	UrlPolicyAction_Allow                    UrlPolicyAction = 1
+	UrlPolicyAction_Deny                     UrlPolicyAction = 2
+)
+
+// Enum value maps for UrlPolicyAction.
+var (
+	UrlPolicyAction_name = map[int32]string{
+		0: "UndefinedUrlPolicyAction",
+		1: "Allow",
+		2: "Deny",
+	}
+	UrlPolicyAction_value = map[string]int32{
+		"UndefinedUrlPolicyAction": 0,
+		"Allow":                    1,
+		"Deny":                     2,
+	}
+)
+
+func (x UrlPolicyAction) Enum() *UrlPolicyAction {
+	p := new(UrlPolicyAction)
+	*p = x
+	return p
+}
+
+func (x UrlPolicyAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UrlPolicyAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_goload_proto_enumTypes[4].Descriptor()
+}
+
+func (UrlPolicyAction) Type() protoreflect.EnumType {
+	return &file_goload_proto_enumTypes[4]
+}
+
+func (x UrlPolicyAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UrlPolicyAction.Descriptor instead.
+func (UrlPolicyAction) EnumDescriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{4}
+}
+
+type ChecksumAlgorithm int32
+
+const (
+	ChecksumAlgorithm_UndefinedChecksumAlgorithm ChecksumAlgorithm = 0
+	ChecksumAlgorithm_MD5                        ChecksumAlgorithm = 1
+	ChecksumAlgorithm_SHA1                       ChecksumAlgorithm = 2
+	ChecksumAlgorithm_SHA256                     ChecksumAlgorithm = 3
+)
+
+// Enum value maps for ChecksumAlgorithm.
+var (
+	ChecksumAlgorithm_name = map[int32]string{
+		0: "UndefinedChecksumAlgorithm",
+		1: "MD5",
+		2: "SHA1",
+		3: "SHA256",
+	}
+	ChecksumAlgorithm_value = map[string]int32{
+		"UndefinedChecksumAlgorithm": 0,
+		"MD5":                        1,
+		"SHA1":                       2,
+		"SHA256":                     3,
+	}
+)
+
+func (x ChecksumAlgorithm) Enum() *ChecksumAlgorithm {
+	p := new(ChecksumAlgorithm)
+	*p = x
+	return p
+}
+
+func (x ChecksumAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChecksumAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_goload_proto_enumTypes[5].Descriptor()
+}
+
+func (ChecksumAlgorithm) Type() protoreflect.EnumType {
+	return &file_goload_proto_enumTypes[5]
+}
+
+func (x ChecksumAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChecksumAlgorithm.Descriptor instead.
+func (ChecksumAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{5}
+}
+
 type Account struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Role          Role                   `protobuf:"varint,3,opt,name=role,proto3,enum=goload.Role" json:"role,omitempty"`
+	Disabled      bool                   `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_goload_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Account) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Account) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_UndefinedRole
+}
+
+func (x *Account) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type DownloadProgress struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DownloadedBytes uint64                 `protobuf:"varint,1,opt,name=downloaded_bytes,json=downloadedBytes,proto3" json:"downloaded_bytes,omitempty"`
+	// Zero when the size of the file is not known yet.
+	TotalBytes     uint64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	BytesPerSecond uint64 `protobuf:"varint,3,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+	// Zero when it can not be estimated.
+	EtaSeconds    uint64 `protobuf:"varint,4,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadProgress) Reset() {
+	*x = DownloadProgress{}
+	mi := &file_goload_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadProgress) ProtoMessage() {}
+
+func (x *DownloadProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadProgress.ProtoReflect.Descriptor instead.
+func (*DownloadProgress) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DownloadProgress) GetDownloadedBytes() uint64 {
+	if x != nil {
+		return x.DownloadedBytes
+	}
+	return 0
+}
+
+func (x *DownloadProgress) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *DownloadProgress) GetBytesPerSecond() uint64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
+func (x *DownloadProgress) GetEtaSeconds() uint64 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
+
+type Checksum struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm ChecksumAlgorithm      `protobuf:"varint,1,opt,name=algorithm,proto3,enum=goload.ChecksumAlgorithm" json:"algorithm,omitempty"`
+	// Hex encoded.
+	Digest        string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Checksum) Reset() {
+	*x = Checksum{}
+	mi := &file_goload_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Checksum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Checksum) ProtoMessage() {}
+
+func (x *Checksum) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Checksum.ProtoReflect.Descriptor instead.
+func (*Checksum) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Checksum) GetAlgorithm() ChecksumAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ChecksumAlgorithm_UndefinedChecksumAlgorithm
+}
+
+func (x *Checksum) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type DownloadTask struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OfAccount      *Account               `protobuf:"bytes,2,opt,name=of_account,json=ofAccount,proto3" json:"of_account,omitempty"`
+	DownloadType   DownloadType           `protobuf:"varint,3,opt,name=download_type,json=downloadType,proto3,enum=goload.DownloadType" json:"download_type,omitempty"`
+	Url            string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	DownloadStatus DownloadStatus         `protobuf:"varint,5,opt,name=download_status,json=downloadStatus,proto3,enum=goload.DownloadStatus" json:"download_status,omitempty"`
+	Progress       *DownloadProgress      `protobuf:"bytes,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	// The checksums of the downloaded file, empty until it is downloaded.
+	Checksums []*Checksum `protobuf:"bytes,7,rep,name=checksums,proto3" json:"checksums,omitempty"`
+	// Zero until the file is downloaded.
+	FileSize uint64 `protobuf:"varint,8,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// As announced by the server, empty until the file is downloaded.
+	ContentType string `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Empty until the file is downloaded.
+	FileName string `protobuf:"bytes,10,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Why the latest attempt failed, empty if the task has not failed since it last succeeded.
+	ErrorMessage string                 `protobuf:"bytes,11,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Set once the task succeeds, fails or is canceled.
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadTask) Reset() {
+	*x = DownloadTask{}
+	mi := &file_goload_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTask) ProtoMessage() {}
+
+func (x *DownloadTask) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTask.ProtoReflect.Descriptor instead.
+func (*DownloadTask) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DownloadTask) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadTask) GetOfAccount() *Account {
+	if x != nil {
+		return x.OfAccount
+	}
+	return nil
+}
+
+func (x *DownloadTask) GetDownloadType() DownloadType {
+	if x != nil {
+		return x.DownloadType
+	}
+	return DownloadType_UndefinedType
+}
+
+func (x *DownloadTask) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DownloadTask) GetDownloadStatus() DownloadStatus {
+	if x != nil {
+		return x.DownloadStatus
+	}
+	return DownloadStatus_UndefinedStatus
+}
+
+func (x *DownloadTask) GetProgress() *DownloadProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *DownloadTask) GetChecksums() []*Checksum {
+	if x != nil {
+		return x.Checksums
+	}
+	return nil
+}
+
+func (x *DownloadTask) GetFileSize() uint64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *DownloadTask) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DownloadTask) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DownloadTask) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *DownloadTask) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DownloadTask) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *DownloadTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_goload_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_goload_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAccountResponse) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type CreateSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
+	mi := &file_goload_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionRequest) ProtoMessage() {}
+
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateSessionRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type CreateSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionResponse) Reset() {
+	*x = CreateSessionResponse{}
+	mi := &file_goload_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionResponse) ProtoMessage() {}
+
+func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateSessionResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateSessionResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateSessionResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_goload_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionRequest) ProtoMessage() {}
+
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RefreshSessionRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
+	mi := &file_goload_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionResponse) ProtoMessage() {}
+
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RefreshSessionResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshSessionResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type DeleteSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionRequest) Reset() {
+	*x = DeleteSessionRequest{}
+	mi := &file_goload_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionRequest) ProtoMessage() {}
+
+func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteSessionRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type DeleteSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionResponse) Reset() {
+	*x = DeleteSessionResponse{}
+	mi := &file_goload_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionResponse) ProtoMessage() {}
+
+func (x *DeleteSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSessionResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteSessionResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_goload_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changed       bool                   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_goload_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangePasswordResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_goload_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestPasswordResetRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_goload_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{15}
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_goload_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResetPasswordRequest) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changed       bool                   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_goload_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResetPasswordResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+// A zero limit means that the account is not limited.
+type AccountUsage struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	DownloadingTaskCount    uint64                 `protobuf:"varint,1,opt,name=downloading_task_count,json=downloadingTaskCount,proto3" json:"downloading_task_count,omitempty"`
+	PendingTaskCount        uint64                 `protobuf:"varint,2,opt,name=pending_task_count,json=pendingTaskCount,proto3" json:"pending_task_count,omitempty"`
+	StoredBytes             uint64                 `protobuf:"varint,3,opt,name=stored_bytes,json=storedBytes,proto3" json:"stored_bytes,omitempty"`
+	MaxDownloadingTaskCount uint64                 `protobuf:"varint,4,opt,name=max_downloading_task_count,json=maxDownloadingTaskCount,proto3" json:"max_downloading_task_count,omitempty"`
+	MaxPendingTaskCount     uint64                 `protobuf:"varint,5,opt,name=max_pending_task_count,json=maxPendingTaskCount,proto3" json:"max_pending_task_count,omitempty"`
+	MaxStoredBytes          uint64                 `protobuf:"varint,6,opt,name=max_stored_bytes,json=maxStoredBytes,proto3" json:"max_stored_bytes,omitempty"`
+	MaxFileSizeInBytes      uint64                 `protobuf:"varint,7,opt,name=max_file_size_in_bytes,json=maxFileSizeInBytes,proto3" json:"max_file_size_in_bytes,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AccountUsage) Reset() {
+	*x = AccountUsage{}
+	mi := &file_goload_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountUsage) ProtoMessage() {}
+
+func (x *AccountUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountUsage.ProtoReflect.Descriptor instead.
+func (*AccountUsage) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AccountUsage) GetDownloadingTaskCount() uint64 {
+	if x != nil {
+		return x.DownloadingTaskCount
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetPendingTaskCount() uint64 {
+	if x != nil {
+		return x.PendingTaskCount
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetStoredBytes() uint64 {
+	if x != nil {
+		return x.StoredBytes
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetMaxDownloadingTaskCount() uint64 {
+	if x != nil {
+		return x.MaxDownloadingTaskCount
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetMaxPendingTaskCount() uint64 {
+	if x != nil {
+		return x.MaxPendingTaskCount
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetMaxStoredBytes() uint64 {
+	if x != nil {
+		return x.MaxStoredBytes
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetMaxFileSizeInBytes() uint64 {
+	if x != nil {
+		return x.MaxFileSizeInBytes
+	}
+	return 0
+}
+
+type GetAccountUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountUsageRequest) Reset() {
+	*x = GetAccountUsageRequest{}
+	mi := &file_goload_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountUsageRequest) ProtoMessage() {}
+
+func (x *GetAccountUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountUsageRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{19}
+}
+
+type GetAccountUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountUsage  *AccountUsage          `protobuf:"bytes,1,opt,name=account_usage,json=accountUsage,proto3" json:"account_usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountUsageResponse) Reset() {
+	*x = GetAccountUsageResponse{}
+	mi := &file_goload_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountUsageResponse) ProtoMessage() {}
+
+func (x *GetAccountUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountUsageResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAccountUsageResponse) GetAccountUsage() *AccountUsage {
+	if x != nil {
+		return x.AccountUsage
+	}
+	return nil
+}
+
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []ApiKeyScope          `protobuf:"varint,3,rep,packed,name=scopes,proto3,enum=goload.ApiKeyScope" json:"scopes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Revoked       bool                   `protobuf:"varint,6,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_goload_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ApiKey) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type CreateApiKeyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []ApiKeyScope          `protobuf:"varint,2,rep,packed,name=scopes,proto3,enum=goload.ApiKeyScope" json:"scopes,omitempty"`
+	// The api key never expires if expires_at is not set.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_goload_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateApiKeyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// key is only returned once, only its hash is stored.
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_goload_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_goload_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{24}
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyList    []*ApiKey              `protobuf:"bytes,1,rep,name=api_key_list,json=apiKeyList,proto3" json:"api_key_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_goload_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListApiKeysResponse) GetApiKeyList() []*ApiKey {
+	if x != nil {
+		return x.ApiKeyList
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_goload_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevokeApiKeyRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       bool                   `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_goload_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RevokeApiKeyResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type CreateDownloadTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Zero uses the maximum file size of the account, a larger value than it has no effect.
+	MaxFileSizeInBytes uint64 `protobuf:"varint,2,opt,name=max_file_size_in_bytes,json=maxFileSizeInBytes,proto3" json:"max_file_size_in_bytes,omitempty"`
+	// The task fails if the downloaded file does not have this checksum.
+	ExpectedChecksum *Checksum `protobuf:"bytes,3,opt,name=expected_checksum,json=expectedChecksum,proto3" json:"expected_checksum,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateDownloadTaskRequest) Reset() {
+	*x = CreateDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDownloadTaskRequest) ProtoMessage() {}
+
+func (x *CreateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateDownloadTaskRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateDownloadTaskRequest) GetMaxFileSizeInBytes() uint64 {
+	if x != nil {
+		return x.MaxFileSizeInBytes
+	}
+	return 0
+}
+
+func (x *CreateDownloadTaskRequest) GetExpectedChecksum() *Checksum {
+	if x != nil {
+		return x.ExpectedChecksum
+	}
+	return nil
+}
+
+type CreateDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDownloadTaskResponse) Reset() {
+	*x = CreateDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDownloadTaskResponse) ProtoMessage() {}
+
+func (x *CreateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateDownloadTaskResponse) GetDownloadTask() *DownloadTask {
+	if x != nil {
+		return x.DownloadTask
+	}
+	return nil
+}
+
+type GetDownloadTaskListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadTaskListRequest) Reset() {
+	*x = GetDownloadTaskListRequest{}
+	mi := &file_goload_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadTaskListRequest) ProtoMessage() {}
+
+func (x *GetDownloadTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadTaskListRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetDownloadTaskListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetDownloadTaskListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDownloadTaskListResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskList       []*DownloadTask        `protobuf:"bytes,1,rep,name=download_task_list,json=downloadTaskList,proto3" json:"download_task_list,omitempty"`
+	TotalDownloadTaskCount uint64                 `protobuf:"varint,2,opt,name=total_download_task_count,json=totalDownloadTaskCount,proto3" json:"total_download_task_count,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetDownloadTaskListResponse) Reset() {
+	*x = GetDownloadTaskListResponse{}
+	mi := &file_goload_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadTaskListResponse) ProtoMessage() {}
+
+func (x *GetDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadTaskListResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetDownloadTaskListResponse) GetDownloadTaskList() []*DownloadTask {
+	if x != nil {
+		return x.DownloadTaskList
+	}
+	return nil
+}
+
+func (x *GetDownloadTaskListResponse) GetTotalDownloadTaskCount() uint64 {
+	if x != nil {
+		return x.TotalDownloadTaskCount
+	}
+	return 0
+}
+
+type UpdateDownloadTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The current url is kept if it is empty.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Only the current status is accepted, the status is changed with CancelDownloadTask, PauseDownloadTask and
+	// ResumeDownloadTask.
+	DownloadTaskStatus DownloadStatus `protobuf:"varint,3,opt,name=download_task_status,json=downloadTaskStatus,proto3,enum=goload.DownloadStatus" json:"download_task_status,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateDownloadTaskRequest) Reset() {
+	*x = UpdateDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDownloadTaskRequest) ProtoMessage() {}
+
+func (x *UpdateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateDownloadTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateDownloadTaskRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateDownloadTaskRequest) GetDownloadTaskStatus() DownloadStatus {
+	if x != nil {
+		return x.DownloadTaskStatus
+	}
+	return DownloadStatus_UndefinedStatus
+}
+
+type UpdateDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDownloadTaskResponse) Reset() {
+	*x = UpdateDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDownloadTaskResponse) ProtoMessage() {}
+
+func (x *UpdateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateDownloadTaskResponse) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+type DeleteDownloadTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDownloadTaskRequest) Reset() {
+	*x = DeleteDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDownloadTaskRequest) ProtoMessage() {}
+
+func (x *DeleteDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteDownloadTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDownloadTaskResponse) Reset() {
+	*x = DeleteDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDownloadTaskResponse) ProtoMessage() {}
+
+func (x *DeleteDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteDownloadTaskResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type CancelDownloadTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDownloadTaskRequest) Reset() {
+	*x = CancelDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDownloadTaskRequest) ProtoMessage() {}
+
+func (x *CancelDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*CancelDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CancelDownloadTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Canceled      bool                   `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDownloadTaskResponse) Reset() {
+	*x = CancelDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDownloadTaskResponse) ProtoMessage() {}
+
+func (x *CancelDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*CancelDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelDownloadTaskResponse) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
+type PauseDownloadTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseDownloadTaskRequest) Reset() {
+	*x = PauseDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseDownloadTaskRequest) ProtoMessage() {}
+
+func (x *PauseDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*PauseDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PauseDownloadTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PauseDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paused        bool                   `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseDownloadTaskResponse) Reset() {
+	*x = PauseDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseDownloadTaskResponse) ProtoMessage() {}
+
+func (x *PauseDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*PauseDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PauseDownloadTaskResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type ResumeDownloadTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeDownloadTaskRequest) Reset() {
+	*x = ResumeDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeDownloadTaskRequest) ProtoMessage() {}
+
+func (x *ResumeDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*ResumeDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResumeDownloadTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ResumeDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resumed       bool                   `protobuf:"varint,1,opt,name=resumed,proto3" json:"resumed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeDownloadTaskResponse) Reset() {
+	*x = ResumeDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeDownloadTaskResponse) ProtoMessage() {}
+
+func (x *ResumeDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*ResumeDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ResumeDownloadTaskResponse) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+type GetDownloadTaskFileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskId uint64                 `protobuf:"varint,2,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDownloadTaskFileRequest) Reset() {
+	*x = GetDownloadTaskFileRequest{}
+	mi := &file_goload_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadTaskFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadTaskFileRequest) ProtoMessage() {}
+
+func (x *GetDownloadTaskFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadTaskFileRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadTaskFileRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetDownloadTaskFileRequest) GetDownloadTaskId() uint64 {
+	if x != nil {
+		return x.DownloadTaskId
+	}
+	return 0
+}
+
+type GetDownloadTaskFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadTaskFileResponse) Reset() {
+	*x = GetDownloadTaskFileResponse{}
+	mi := &file_goload_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadTaskFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadTaskFileResponse) ProtoMessage() {}
+
+func (x *GetDownloadTaskFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadTaskFileResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadTaskFileResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDownloadTaskFileResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WatchDownloadTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDownloadTaskRequest) Reset() {
+	*x = WatchDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDownloadTaskRequest) ProtoMessage() {}
+
+func (x *WatchDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*WatchDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WatchDownloadTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WatchDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDownloadTaskResponse) Reset() {
+	*x = WatchDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDownloadTaskResponse) ProtoMessage() {}
+
+func (x *WatchDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*WatchDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WatchDownloadTaskResponse) GetDownloadTask() *DownloadTask {
+	if x != nil {
+		return x.DownloadTask
+	}
+	return nil
+}
+
+type AdminGetDownloadTaskListRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Offset uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only list the download tasks of this account, every account's when zero.
+	OfAccountId   uint64 `protobuf:"varint,3,opt,name=of_account_id,json=ofAccountId,proto3" json:"of_account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetDownloadTaskListRequest) Reset() {
+	*x = AdminGetDownloadTaskListRequest{}
+	mi := &file_goload_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetDownloadTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetDownloadTaskListRequest) ProtoMessage() {}
+
+func (x *AdminGetDownloadTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetDownloadTaskListRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetDownloadTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AdminGetDownloadTaskListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AdminGetDownloadTaskListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AdminGetDownloadTaskListRequest) GetOfAccountId() uint64 {
+	if x != nil {
+		return x.OfAccountId
+	}
+	return 0
+}
+
+type AdminGetDownloadTaskListResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskList       []*DownloadTask        `protobuf:"bytes,1,rep,name=download_task_list,json=downloadTaskList,proto3" json:"download_task_list,omitempty"`
+	TotalDownloadTaskCount uint64                 `protobuf:"varint,2,opt,name=total_download_task_count,json=totalDownloadTaskCount,proto3" json:"total_download_task_count,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AdminGetDownloadTaskListResponse) Reset() {
+	*x = AdminGetDownloadTaskListResponse{}
+	mi := &file_goload_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetDownloadTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetDownloadTaskListResponse) ProtoMessage() {}
+
+func (x *AdminGetDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetDownloadTaskListResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetDownloadTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AdminGetDownloadTaskListResponse) GetDownloadTaskList() []*DownloadTask {
+	if x != nil {
+		return x.DownloadTaskList
+	}
+	return nil
+}
+
+func (x *AdminGetDownloadTaskListResponse) GetTotalDownloadTaskCount() uint64 {
+	if x != nil {
+		return x.TotalDownloadTaskCount
+	}
+	return 0
+}
+
+type AdminFailDownloadTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminFailDownloadTaskRequest) Reset() {
+	*x = AdminFailDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminFailDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminFailDownloadTaskRequest) ProtoMessage() {}
+
+func (x *AdminFailDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminFailDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*AdminFailDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AdminFailDownloadTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminFailDownloadTaskRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminFailDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Failed        bool                   `protobuf:"varint,1,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminFailDownloadTaskResponse) Reset() {
+	*x = AdminFailDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminFailDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminFailDownloadTaskResponse) ProtoMessage() {}
+
+func (x *AdminFailDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminFailDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*AdminFailDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AdminFailDownloadTaskResponse) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+type AdminRetryDownloadTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
-	mi := &file_goload_proto_msgTypes[0]
+func (x *AdminRetryDownloadTaskRequest) Reset() {
+	*x = AdminRetryDownloadTaskRequest{}
+	mi := &file_goload_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Account) String() string {
+func (x *AdminRetryDownloadTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*AdminRetryDownloadTaskRequest) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[0]
+func (x *AdminRetryDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -157,51 +2975,84 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use AdminRetryDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*AdminRetryDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Account) GetId() uint64 {
+func (x *AdminRetryDownloadTaskRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Account) GetAccountName() string {
+type AdminRetryDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retried       bool                   `protobuf:"varint,1,opt,name=retried,proto3" json:"retried,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRetryDownloadTaskResponse) Reset() {
+	*x = AdminRetryDownloadTaskResponse{}
+	mi := &file_goload_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRetryDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRetryDownloadTaskResponse) ProtoMessage() {}
+
+func (x *AdminRetryDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[51]
 	if x != nil {
-		return x.AccountName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type DownloadTask struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OfAccount      *Account               `protobuf:"bytes,2,opt,name=of_account,json=ofAccount,proto3" json:"of_account,omitempty"`
-	DownloadType   DownloadType           `protobuf:"varint,3,opt,name=download_type,json=downloadType,proto3,enum=goload.DownloadType" json:"download_type,omitempty"`
-	Url            string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	DownloadStatus DownloadStatus         `protobuf:"varint,5,opt,name=download_status,json=downloadStatus,proto3,enum=goload.DownloadStatus" json:"download_status,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Deprecated: Use AdminRetryDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*AdminRetryDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DownloadTask) Reset() {
-	*x = DownloadTask{}
-	mi := &file_goload_proto_msgTypes[1]
+func (x *AdminRetryDownloadTaskResponse) GetRetried() bool {
+	if x != nil {
+		return x.Retried
+	}
+	return false
+}
+
+type AdminDisableAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDisableAccountRequest) Reset() {
+	*x = AdminDisableAccountRequest{}
+	mi := &file_goload_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadTask) String() string {
+func (x *AdminDisableAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadTask) ProtoMessage() {}
+func (*AdminDisableAccountRequest) ProtoMessage() {}
 
-func (x *DownloadTask) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[1]
+func (x *AdminDisableAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,69 +3063,85 @@ func (x *DownloadTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadTask.ProtoReflect.Descriptor instead.
-func (*DownloadTask) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use AdminDisableAccountRequest.ProtoReflect.Descriptor instead.
+func (*AdminDisableAccountRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DownloadTask) GetId() uint64 {
+func (x *AdminDisableAccountRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *DownloadTask) GetOfAccount() *Account {
-	if x != nil {
-		return x.OfAccount
-	}
-	return nil
+type AdminDisableAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disabled      bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadTask) GetDownloadType() DownloadType {
-	if x != nil {
-		return x.DownloadType
-	}
-	return DownloadType_UndefinedType
+func (x *AdminDisableAccountResponse) Reset() {
+	*x = AdminDisableAccountResponse{}
+	mi := &file_goload_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadTask) GetUrl() string {
+func (x *AdminDisableAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDisableAccountResponse) ProtoMessage() {}
+
+func (x *AdminDisableAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[53]
 	if x != nil {
-		return x.Url
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DownloadTask) GetDownloadStatus() DownloadStatus {
+// Deprecated: Use AdminDisableAccountResponse.ProtoReflect.Descriptor instead.
+func (*AdminDisableAccountResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AdminDisableAccountResponse) GetDisabled() bool {
 	if x != nil {
-		return x.DownloadStatus
+		return x.Disabled
 	}
-	return DownloadStatus_UndefinedStatus
+	return false
 }
 
-type CreateAccountRequest struct {
+type AdminUpdateAccountRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=goload.Role" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountRequest) Reset() {
-	*x = CreateAccountRequest{}
-	mi := &file_goload_proto_msgTypes[2]
+func (x *AdminUpdateAccountRoleRequest) Reset() {
+	*x = AdminUpdateAccountRoleRequest{}
+	mi := &file_goload_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountRequest) String() string {
+func (x *AdminUpdateAccountRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountRequest) ProtoMessage() {}
+func (*AdminUpdateAccountRoleRequest) ProtoMessage() {}
 
-func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[2]
+func (x *AdminUpdateAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,47 +3152,47 @@ func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use AdminUpdateAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CreateAccountRequest) GetAccountName() string {
+func (x *AdminUpdateAccountRoleRequest) GetId() uint64 {
 	if x != nil {
-		return x.AccountName
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateAccountRequest) GetPassword() string {
+func (x *AdminUpdateAccountRoleRequest) GetRole() Role {
 	if x != nil {
-		return x.Password
+		return x.Role
 	}
-	return ""
+	return Role_UndefinedRole
 }
 
-type CreateAccountResponse struct {
+type AdminUpdateAccountRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Updated       bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountResponse) Reset() {
-	*x = CreateAccountResponse{}
-	mi := &file_goload_proto_msgTypes[3]
+func (x *AdminUpdateAccountRoleResponse) Reset() {
+	*x = AdminUpdateAccountRoleResponse{}
+	mi := &file_goload_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountResponse) String() string {
+func (x *AdminUpdateAccountRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountResponse) ProtoMessage() {}
+func (*AdminUpdateAccountRoleResponse) ProtoMessage() {}
 
-func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[3]
+func (x *AdminUpdateAccountRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,41 +3203,42 @@ func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
-func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AdminUpdateAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*AdminUpdateAccountRoleResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateAccountResponse) GetAccountId() uint64 {
+func (x *AdminUpdateAccountRoleResponse) GetUpdated() bool {
 	if x != nil {
-		return x.AccountId
+		return x.Updated
 	}
-	return 0
+	return false
 }
 
-type CreateSessionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AdminUpdateAccountMaxFileSizeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Zero resets the account to the configured maximum file size.
+	MaxFileSizeInBytes uint64 `protobuf:"varint,2,opt,name=max_file_size_in_bytes,json=maxFileSizeInBytes,proto3" json:"max_file_size_in_bytes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CreateSessionRequest) Reset() {
-	*x = CreateSessionRequest{}
-	mi := &file_goload_proto_msgTypes[4]
+func (x *AdminUpdateAccountMaxFileSizeRequest) Reset() {
+	*x = AdminUpdateAccountMaxFileSizeRequest{}
+	mi := &file_goload_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSessionRequest) String() string {
+func (x *AdminUpdateAccountMaxFileSizeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionRequest) ProtoMessage() {}
+func (*AdminUpdateAccountMaxFileSizeRequest) ProtoMessage() {}
 
-func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[4]
+func (x *AdminUpdateAccountMaxFileSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,48 +3249,47 @@ func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AdminUpdateAccountMaxFileSizeRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateAccountMaxFileSizeRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateSessionRequest) GetAccountName() string {
+func (x *AdminUpdateAccountMaxFileSizeRequest) GetId() uint64 {
 	if x != nil {
-		return x.AccountName
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSessionRequest) GetPassword() string {
+func (x *AdminUpdateAccountMaxFileSizeRequest) GetMaxFileSizeInBytes() uint64 {
 	if x != nil {
-		return x.Password
+		return x.MaxFileSizeInBytes
 	}
-	return ""
+	return 0
 }
 
-type CreateSessionResponse struct {
+type AdminUpdateAccountMaxFileSizeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Updated       bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSessionResponse) Reset() {
-	*x = CreateSessionResponse{}
-	mi := &file_goload_proto_msgTypes[5]
+func (x *AdminUpdateAccountMaxFileSizeResponse) Reset() {
+	*x = AdminUpdateAccountMaxFileSizeResponse{}
+	mi := &file_goload_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSessionResponse) String() string {
+func (x *AdminUpdateAccountMaxFileSizeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionResponse) ProtoMessage() {}
+func (*AdminUpdateAccountMaxFileSizeResponse) ProtoMessage() {}
 
-func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[5]
+func (x *AdminUpdateAccountMaxFileSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,47 +3300,52 @@ func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
-func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CreateSessionResponse) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
+// Deprecated: Use AdminUpdateAccountMaxFileSizeResponse.ProtoReflect.Descriptor instead.
+func (*AdminUpdateAccountMaxFileSizeResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateSessionResponse) GetToken() string {
+func (x *AdminUpdateAccountMaxFileSizeResponse) GetUpdated() bool {
 	if x != nil {
-		return x.Token
+		return x.Updated
 	}
-	return ""
+	return false
 }
 
-type CreateDownloadTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+// UrlPolicy matches the download urls that meet all of its set conditions, at least one has to be set.
+type UrlPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Zero for a global policy, which applies to every account.
+	OfAccountId uint64          `protobuf:"varint,2,opt,name=of_account_id,json=ofAccountId,proto3" json:"of_account_id,omitempty"`
+	Action      UrlPolicyAction `protobuf:"varint,3,opt,name=action,proto3,enum=goload.UrlPolicyAction" json:"action,omitempty"`
+	// A glob such as "*.example.com", matched against the host name.
+	HostGlob string `protobuf:"bytes,4,opt,name=host_glob,json=hostGlob,proto3" json:"host_glob,omitempty"`
+	// A regular expression matched against the whole url.
+	UrlRegex      string                 `protobuf:"bytes,5,opt,name=url_regex,json=urlRegex,proto3" json:"url_regex,omitempty"`
+	Scheme        string                 `protobuf:"bytes,6,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Port          uint32                 `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDownloadTaskRequest) Reset() {
-	*x = CreateDownloadTaskRequest{}
-	mi := &file_goload_proto_msgTypes[6]
+func (x *UrlPolicy) Reset() {
+	*x = UrlPolicy{}
+	mi := &file_goload_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDownloadTaskRequest) String() string {
+func (x *UrlPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDownloadTaskRequest) ProtoMessage() {}
+func (*UrlPolicy) ProtoMessage() {}
 
-func (x *CreateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[6]
+func (x *UrlPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,40 +3356,102 @@ func (x *CreateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDownloadTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateDownloadTaskRequest) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UrlPolicy.ProtoReflect.Descriptor instead.
+func (*UrlPolicy) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UrlPolicy) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UrlPolicy) GetOfAccountId() uint64 {
+	if x != nil {
+		return x.OfAccountId
+	}
+	return 0
+}
+
+func (x *UrlPolicy) GetAction() UrlPolicyAction {
+	if x != nil {
+		return x.Action
+	}
+	return UrlPolicyAction_UndefinedUrlPolicyAction
+}
+
+func (x *UrlPolicy) GetHostGlob() string {
+	if x != nil {
+		return x.HostGlob
+	}
+	return ""
+}
+
+func (x *UrlPolicy) GetUrlRegex() string {
+	if x != nil {
+		return x.UrlRegex
+	}
+	return ""
+}
+
+func (x *UrlPolicy) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *UrlPolicy) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
 }
 
-func (x *CreateDownloadTaskRequest) GetUrl() string {
+func (x *UrlPolicy) GetDescription() string {
 	if x != nil {
-		return x.Url
+		return x.Description
 	}
 	return ""
 }
 
-type CreateDownloadTaskResponse struct {
+func (x *UrlPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AdminCreateUrlPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	OfAccountId   uint64                 `protobuf:"varint,1,opt,name=of_account_id,json=ofAccountId,proto3" json:"of_account_id,omitempty"`
+	Action        UrlPolicyAction        `protobuf:"varint,2,opt,name=action,proto3,enum=goload.UrlPolicyAction" json:"action,omitempty"`
+	HostGlob      string                 `protobuf:"bytes,3,opt,name=host_glob,json=hostGlob,proto3" json:"host_glob,omitempty"`
+	UrlRegex      string                 `protobuf:"bytes,4,opt,name=url_regex,json=urlRegex,proto3" json:"url_regex,omitempty"`
+	Scheme        string                 `protobuf:"bytes,5,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Port          uint32                 `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDownloadTaskResponse) Reset() {
-	*x = CreateDownloadTaskResponse{}
-	mi := &file_goload_proto_msgTypes[7]
+func (x *AdminCreateUrlPolicyRequest) Reset() {
+	*x = AdminCreateUrlPolicyRequest{}
+	mi := &file_goload_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDownloadTaskResponse) String() string {
+func (x *AdminCreateUrlPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDownloadTaskResponse) ProtoMessage() {}
+func (*AdminCreateUrlPolicyRequest) ProtoMessage() {}
 
-func (x *CreateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[7]
+func (x *AdminCreateUrlPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,93 +3462,82 @@ func (x *CreateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDownloadTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateDownloadTaskResponse) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AdminCreateUrlPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AdminCreateUrlPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CreateDownloadTaskResponse) GetDownloadTask() *DownloadTask {
+func (x *AdminCreateUrlPolicyRequest) GetOfAccountId() uint64 {
 	if x != nil {
-		return x.DownloadTask
+		return x.OfAccountId
 	}
-	return nil
-}
-
-type GetDownloadTaskListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offset        uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *GetDownloadTaskListRequest) Reset() {
-	*x = GetDownloadTaskListRequest{}
-	mi := &file_goload_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AdminCreateUrlPolicyRequest) GetAction() UrlPolicyAction {
+	if x != nil {
+		return x.Action
+	}
+	return UrlPolicyAction_UndefinedUrlPolicyAction
 }
 
-func (x *GetDownloadTaskListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminCreateUrlPolicyRequest) GetHostGlob() string {
+	if x != nil {
+		return x.HostGlob
+	}
+	return ""
 }
 
-func (*GetDownloadTaskListRequest) ProtoMessage() {}
-
-func (x *GetDownloadTaskListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[8]
+func (x *AdminCreateUrlPolicyRequest) GetUrlRegex() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UrlRegex
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetDownloadTaskListRequest.ProtoReflect.Descriptor instead.
-func (*GetDownloadTaskListRequest) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{8}
+func (x *AdminCreateUrlPolicyRequest) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
 }
 
-func (x *GetDownloadTaskListRequest) GetOffset() uint64 {
+func (x *AdminCreateUrlPolicyRequest) GetPort() uint32 {
 	if x != nil {
-		return x.Offset
+		return x.Port
 	}
 	return 0
 }
 
-func (x *GetDownloadTaskListRequest) GetLimit() uint64 {
+func (x *AdminCreateUrlPolicyRequest) GetDescription() string {
 	if x != nil {
-		return x.Limit
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-type GetDownloadTaskListResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTaskList       []*DownloadTask        `protobuf:"bytes,1,rep,name=download_task_list,json=downloadTaskList,proto3" json:"download_task_list,omitempty"`
-	TotalDownloadTaskCount uint64                 `protobuf:"varint,2,opt,name=total_download_task_count,json=totalDownloadTaskCount,proto3" json:"total_download_task_count,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type AdminCreateUrlPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UrlPolicy     *UrlPolicy             `protobuf:"bytes,1,opt,name=url_policy,json=urlPolicy,proto3" json:"url_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDownloadTaskListResponse) Reset() {
-	*x = GetDownloadTaskListResponse{}
-	mi := &file_goload_proto_msgTypes[9]
+func (x *AdminCreateUrlPolicyResponse) Reset() {
+	*x = AdminCreateUrlPolicyResponse{}
+	mi := &file_goload_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadTaskListResponse) String() string {
+func (x *AdminCreateUrlPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadTaskListResponse) ProtoMessage() {}
+func (*AdminCreateUrlPolicyResponse) ProtoMessage() {}
 
-func (x *GetDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[9]
+func (x *AdminCreateUrlPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,49 +3548,43 @@ func (x *GetDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadTaskListResponse.ProtoReflect.Descriptor instead.
-func (*GetDownloadTaskListResponse) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AdminCreateUrlPolicyResponse.ProtoReflect.Descriptor instead.
+func (*AdminCreateUrlPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetDownloadTaskListResponse) GetDownloadTaskList() []*DownloadTask {
+func (x *AdminCreateUrlPolicyResponse) GetUrlPolicy() *UrlPolicy {
 	if x != nil {
-		return x.DownloadTaskList
+		return x.UrlPolicy
 	}
 	return nil
 }
 
-func (x *GetDownloadTaskListResponse) GetTotalDownloadTaskCount() uint64 {
-	if x != nil {
-		return x.TotalDownloadTaskCount
-	}
-	return 0
-}
-
-type UpdateDownloadTaskRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url                string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	DownloadTaskStatus DownloadStatus         `protobuf:"varint,3,opt,name=download_task_status,json=downloadTaskStatus,proto3,enum=goload.DownloadStatus" json:"download_task_status,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type AdminGetUrlPolicyListRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Offset uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only list the policies of this account, every policy when zero.
+	OfAccountId   uint64 `protobuf:"varint,3,opt,name=of_account_id,json=ofAccountId,proto3" json:"of_account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDownloadTaskRequest) Reset() {
-	*x = UpdateDownloadTaskRequest{}
-	mi := &file_goload_proto_msgTypes[10]
+func (x *AdminGetUrlPolicyListRequest) Reset() {
+	*x = AdminGetUrlPolicyListRequest{}
+	mi := &file_goload_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDownloadTaskRequest) String() string {
+func (x *AdminGetUrlPolicyListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDownloadTaskRequest) ProtoMessage() {}
+func (*AdminGetUrlPolicyListRequest) ProtoMessage() {}
 
-func (x *UpdateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[10]
+func (x *AdminGetUrlPolicyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,54 +3595,55 @@ func (x *UpdateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDownloadTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDownloadTaskRequest) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AdminGetUrlPolicyListRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetUrlPolicyListRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *UpdateDownloadTaskRequest) GetId() uint64 {
+func (x *AdminGetUrlPolicyListRequest) GetOffset() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *UpdateDownloadTaskRequest) GetUrl() string {
+func (x *AdminGetUrlPolicyListRequest) GetLimit() uint64 {
 	if x != nil {
-		return x.Url
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateDownloadTaskRequest) GetDownloadTaskStatus() DownloadStatus {
+func (x *AdminGetUrlPolicyListRequest) GetOfAccountId() uint64 {
 	if x != nil {
-		return x.DownloadTaskStatus
+		return x.OfAccountId
 	}
-	return DownloadStatus_UndefinedStatus
+	return 0
 }
 
-type UpdateDownloadTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Updated       bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AdminGetUrlPolicyListResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UrlPolicyList       []*UrlPolicy           `protobuf:"bytes,1,rep,name=url_policy_list,json=urlPolicyList,proto3" json:"url_policy_list,omitempty"`
+	TotalUrlPolicyCount uint64                 `protobuf:"varint,2,opt,name=total_url_policy_count,json=totalUrlPolicyCount,proto3" json:"total_url_policy_count,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *UpdateDownloadTaskResponse) Reset() {
-	*x = UpdateDownloadTaskResponse{}
-	mi := &file_goload_proto_msgTypes[11]
+func (x *AdminGetUrlPolicyListResponse) Reset() {
+	*x = AdminGetUrlPolicyListResponse{}
+	mi := &file_goload_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDownloadTaskResponse) String() string {
+func (x *AdminGetUrlPolicyListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDownloadTaskResponse) ProtoMessage() {}
+func (*AdminGetUrlPolicyListResponse) ProtoMessage() {}
 
-func (x *UpdateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[11]
+func (x *AdminGetUrlPolicyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,40 +3654,54 @@ func (x *UpdateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDownloadTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDownloadTaskResponse) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AdminGetUrlPolicyListResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetUrlPolicyListResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UpdateDownloadTaskResponse) GetUpdated() bool {
+func (x *AdminGetUrlPolicyListResponse) GetUrlPolicyList() []*UrlPolicy {
 	if x != nil {
-		return x.Updated
+		return x.UrlPolicyList
 	}
-	return false
+	return nil
 }
 
-type DeleteDownloadTaskRequest struct {
+func (x *AdminGetUrlPolicyListResponse) GetTotalUrlPolicyCount() uint64 {
+	if x != nil {
+		return x.TotalUrlPolicyCount
+	}
+	return 0
+}
+
+// AdminUpdateUrlPolicyRequest replaces every field of the policy but the account it applies to.
+type AdminUpdateUrlPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action        UrlPolicyAction        `protobuf:"varint,2,opt,name=action,proto3,enum=goload.UrlPolicyAction" json:"action,omitempty"`
+	HostGlob      string                 `protobuf:"bytes,3,opt,name=host_glob,json=hostGlob,proto3" json:"host_glob,omitempty"`
+	UrlRegex      string                 `protobuf:"bytes,4,opt,name=url_regex,json=urlRegex,proto3" json:"url_regex,omitempty"`
+	Scheme        string                 `protobuf:"bytes,5,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Port          uint32                 `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDownloadTaskRequest) Reset() {
-	*x = DeleteDownloadTaskRequest{}
-	mi := &file_goload_proto_msgTypes[12]
+func (x *AdminUpdateUrlPolicyRequest) Reset() {
+	*x = AdminUpdateUrlPolicyRequest{}
+	mi := &file_goload_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDownloadTaskRequest) String() string {
+func (x *AdminUpdateUrlPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDownloadTaskRequest) ProtoMessage() {}
+func (*AdminUpdateUrlPolicyRequest) ProtoMessage() {}
 
-func (x *DeleteDownloadTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[12]
+func (x *AdminUpdateUrlPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,40 +3712,82 @@ func (x *DeleteDownloadTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDownloadTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDownloadTaskRequest) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AdminUpdateUrlPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateUrlPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DeleteDownloadTaskRequest) GetId() uint64 {
+func (x *AdminUpdateUrlPolicyRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteDownloadTaskResponse struct {
+func (x *AdminUpdateUrlPolicyRequest) GetAction() UrlPolicyAction {
+	if x != nil {
+		return x.Action
+	}
+	return UrlPolicyAction_UndefinedUrlPolicyAction
+}
+
+func (x *AdminUpdateUrlPolicyRequest) GetHostGlob() string {
+	if x != nil {
+		return x.HostGlob
+	}
+	return ""
+}
+
+func (x *AdminUpdateUrlPolicyRequest) GetUrlRegex() string {
+	if x != nil {
+		return x.UrlRegex
+	}
+	return ""
+}
+
+func (x *AdminUpdateUrlPolicyRequest) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *AdminUpdateUrlPolicyRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AdminUpdateUrlPolicyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type AdminUpdateUrlPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	UrlPolicy     *UrlPolicy             `protobuf:"bytes,1,opt,name=url_policy,json=urlPolicy,proto3" json:"url_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDownloadTaskResponse) Reset() {
-	*x = DeleteDownloadTaskResponse{}
-	mi := &file_goload_proto_msgTypes[13]
+func (x *AdminUpdateUrlPolicyResponse) Reset() {
+	*x = AdminUpdateUrlPolicyResponse{}
+	mi := &file_goload_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDownloadTaskResponse) String() string {
+func (x *AdminUpdateUrlPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDownloadTaskResponse) ProtoMessage() {}
+func (*AdminUpdateUrlPolicyResponse) ProtoMessage() {}
 
-func (x *DeleteDownloadTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[13]
+func (x *AdminUpdateUrlPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,40 +3798,40 @@ func (x *DeleteDownloadTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDownloadTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDownloadTaskResponse) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AdminUpdateUrlPolicyResponse.ProtoReflect.Descriptor instead.
+func (*AdminUpdateUrlPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *DeleteDownloadTaskResponse) GetDeleted() bool {
+func (x *AdminUpdateUrlPolicyResponse) GetUrlPolicy() *UrlPolicy {
 	if x != nil {
-		return x.Deleted
+		return x.UrlPolicy
 	}
-	return false
+	return nil
 }
 
-type GetDownloadTaskFileRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTaskId uint64                 `protobuf:"varint,2,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type AdminDeleteUrlPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDownloadTaskFileRequest) Reset() {
-	*x = GetDownloadTaskFileRequest{}
-	mi := &file_goload_proto_msgTypes[14]
+func (x *AdminDeleteUrlPolicyRequest) Reset() {
+	*x = AdminDeleteUrlPolicyRequest{}
+	mi := &file_goload_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadTaskFileRequest) String() string {
+func (x *AdminDeleteUrlPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadTaskFileRequest) ProtoMessage() {}
+func (*AdminDeleteUrlPolicyRequest) ProtoMessage() {}
 
-func (x *GetDownloadTaskFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[14]
+func (x *AdminDeleteUrlPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,40 +3842,40 @@ func (x *GetDownloadTaskFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadTaskFileRequest.ProtoReflect.Descriptor instead.
-func (*GetDownloadTaskFileRequest) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AdminDeleteUrlPolicyRequest.ProtoReflect.Descriptor instead.
+func (*AdminDeleteUrlPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetDownloadTaskFileRequest) GetDownloadTaskId() uint64 {
+func (x *AdminDeleteUrlPolicyRequest) GetId() uint64 {
 	if x != nil {
-		return x.DownloadTaskId
+		return x.Id
 	}
 	return 0
 }
 
-type GetDownloadTaskFileResponse struct {
+type AdminDeleteUrlPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDownloadTaskFileResponse) Reset() {
-	*x = GetDownloadTaskFileResponse{}
-	mi := &file_goload_proto_msgTypes[15]
+func (x *AdminDeleteUrlPolicyResponse) Reset() {
+	*x = AdminDeleteUrlPolicyResponse{}
+	mi := &file_goload_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadTaskFileResponse) String() string {
+func (x *AdminDeleteUrlPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadTaskFileResponse) ProtoMessage() {}
+func (*AdminDeleteUrlPolicyResponse) ProtoMessage() {}
 
-func (x *GetDownloadTaskFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goload_proto_msgTypes[15]
+func (x *AdminDeleteUrlPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goload_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,33 +3886,57 @@ func (x *GetDownloadTaskFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadTaskFileResponse.ProtoReflect.Descriptor instead.
-func (*GetDownloadTaskFileResponse) Descriptor() ([]byte, []int) {
-	return file_goload_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AdminDeleteUrlPolicyResponse.ProtoReflect.Descriptor instead.
+func (*AdminDeleteUrlPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_goload_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetDownloadTaskFileResponse) GetData() []byte {
+func (x *AdminDeleteUrlPolicyResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Data
+		return x.Deleted
 	}
-	return nil
+	return false
 }
 
 var File_goload_proto protoreflect.FileDescriptor
 
 const file_goload_proto_rawDesc = "" +
 	"\n" +
-	"\fgoload.proto\x12\x06goload\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\"<\n" +
+	"\fgoload.proto\x12\x06goload\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"z\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
-	"\faccount_name\x18\x02 \x01(\tR\vaccountName\"\xdc\x01\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12 \n" +
+	"\x04role\x18\x03 \x01(\x0e2\f.goload.RoleR\x04role\x12\x1a\n" +
+	"\bdisabled\x18\x04 \x01(\bR\bdisabled\"\xa9\x01\n" +
+	"\x10DownloadProgress\x12)\n" +
+	"\x10downloaded_bytes\x18\x01 \x01(\x04R\x0fdownloadedBytes\x12\x1f\n" +
+	"\vtotal_bytes\x18\x02 \x01(\x04R\n" +
+	"totalBytes\x12(\n" +
+	"\x10bytes_per_second\x18\x03 \x01(\x04R\x0ebytesPerSecond\x12\x1f\n" +
+	"\veta_seconds\x18\x04 \x01(\x04R\n" +
+	"etaSeconds\"|\n" +
+	"\bChecksum\x12A\n" +
+	"\talgorithm\x18\x01 \x01(\x0e2\x19.goload.ChecksumAlgorithmB\b\xfaB\x05\x82\x01\x02\x10\x01R\talgorithm\x12-\n" +
+	"\x06digest\x18\x02 \x01(\tB\x15\xfaB\x12r\x102\x0e^[0-9a-fA-F]+$R\x06digest\"\xf9\x04\n" +
 	"\fDownloadTask\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12.\n" +
 	"\n" +
 	"of_account\x18\x02 \x01(\v2\x0f.goload.AccountR\tofAccount\x129\n" +
 	"\rdownload_type\x18\x03 \x01(\x0e2\x14.goload.DownloadTypeR\fdownloadType\x12\x10\n" +
 	"\x03url\x18\x04 \x01(\tR\x03url\x12?\n" +
-	"\x0fdownload_status\x18\x05 \x01(\x0e2\x16.goload.DownloadStatusR\x0edownloadStatus\"\x8d\x01\n" +
+	"\x0fdownload_status\x18\x05 \x01(\x0e2\x16.goload.DownloadStatusR\x0edownloadStatus\x124\n" +
+	"\bprogress\x18\x06 \x01(\v2\x18.goload.DownloadProgressR\bprogress\x12.\n" +
+	"\tchecksums\x18\a \x03(\v2\x10.goload.ChecksumR\tchecksums\x12\x1b\n" +
+	"\tfile_size\x18\b \x01(\x04R\bfileSize\x12!\n" +
+	"\fcontent_type\x18\t \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_name\x18\n" +
+	" \x01(\tR\bfileName\x12#\n" +
+	"\rerror_message\x18\v \x01(\tR\ferrorMessage\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x8d\x01\n" +
 	"\x14CreateAccountRequest\x12=\n" +
 	"\faccount_name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vaccountName\x126\n" +
 	"\bpassword\x18\x02 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\bpassword\"6\n" +
@@ -947,12 +3945,75 @@ const file_goload_proto_rawDesc = "" +
 	"account_id\x18\x01 \x01(\x04R\taccountId\"\x8d\x01\n" +
 	"\x14CreateSessionRequest\x12=\n" +
 	"\faccount_name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vaccountName\x126\n" +
-	"\bpassword\x18\x02 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\bpassword\"X\n" +
+	"\bpassword\x18\x02 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\bpassword\"}\n" +
 	"\x15CreateSessionResponse\x12)\n" +
 	"\aaccount\x18\x01 \x01(\v2\x0f.goload.AccountR\aaccount\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"7\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\"E\n" +
+	"\x15RefreshSessionRequest\x12,\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\frefreshToken\"S\n" +
+	"\x16RefreshSessionResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"D\n" +
+	"\x14DeleteSessionRequest\x12,\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\frefreshToken\"1\n" +
+	"\x15DeleteSessionResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"\x95\x01\n" +
+	"\x15ChangePasswordRequest\x12=\n" +
+	"\fold_password\x18\x01 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\voldPassword\x12=\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vnewPassword\"2\n" +
+	"\x16ChangePasswordResponse\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\bR\achanged\"\\\n" +
+	"\x1bRequestPasswordResetRequest\x12=\n" +
+	"\faccount_name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vaccountName\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"\x7f\n" +
+	"\x14ResetPasswordRequest\x12(\n" +
+	"\vreset_token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"resetToken\x12=\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x1a\xfaB\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vnewPassword\"1\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\bR\achanged\"\xe5\x02\n" +
+	"\fAccountUsage\x124\n" +
+	"\x16downloading_task_count\x18\x01 \x01(\x04R\x14downloadingTaskCount\x12,\n" +
+	"\x12pending_task_count\x18\x02 \x01(\x04R\x10pendingTaskCount\x12!\n" +
+	"\fstored_bytes\x18\x03 \x01(\x04R\vstoredBytes\x12;\n" +
+	"\x1amax_downloading_task_count\x18\x04 \x01(\x04R\x17maxDownloadingTaskCount\x123\n" +
+	"\x16max_pending_task_count\x18\x05 \x01(\x04R\x13maxPendingTaskCount\x12(\n" +
+	"\x10max_stored_bytes\x18\x06 \x01(\x04R\x0emaxStoredBytes\x122\n" +
+	"\x16max_file_size_in_bytes\x18\a \x01(\x04R\x12maxFileSizeInBytes\"\x18\n" +
+	"\x16GetAccountUsageRequest\"T\n" +
+	"\x17GetAccountUsageResponse\x129\n" +
+	"\raccount_usage\x18\x01 \x01(\v2\x14.goload.AccountUsageR\faccountUsage\"\xe9\x01\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x06scopes\x18\x03 \x03(\x0e2\x13.goload.ApiKeyScopeR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\arevoked\x18\x06 \x01(\bR\arevoked\"\xa6\x01\n" +
+	"\x13CreateApiKeyRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x125\n" +
+	"\x06scopes\x18\x02 \x03(\x0e2\x13.goload.ApiKeyScopeB\b\xfaB\x05\x92\x01\x02\b\x01R\x06scopes\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"Q\n" +
+	"\x14CreateApiKeyResponse\x12'\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x0e.goload.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\x14\n" +
+	"\x12ListApiKeysRequest\"G\n" +
+	"\x13ListApiKeysResponse\x120\n" +
+	"\fapi_key_list\x18\x01 \x03(\v2\x0e.goload.ApiKeyR\n" +
+	"apiKeyList\"%\n" +
+	"\x13RevokeApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"0\n" +
+	"\x14RevokeApiKeyResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\bR\arevoked\"\xbb\x01\n" +
 	"\x19CreateDownloadTaskRequest\x12\x1a\n" +
-	"\x03url\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x03url\"W\n" +
+	"\x03url\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x03url\x12C\n" +
+	"\x16max_file_size_in_bytes\x18\x02 \x01(\x04B\x0f\xfaB\f2\n" +
+	"\x18\xff\xff\xff\xff\xff\xff\xff\xff\x7fR\x12maxFileSizeInBytes\x12=\n" +
+	"\x11expected_checksum\x18\x03 \x01(\v2\x10.goload.ChecksumR\x10expectedChecksum\"W\n" +
 	"\x1aCreateDownloadTaskResponse\x129\n" +
 	"\rdownload_task\x18\x01 \x01(\v2\x14.goload.DownloadTaskR\fdownloadTask\"S\n" +
 	"\x1aGetDownloadTaskListRequest\x12\x16\n" +
@@ -960,39 +4021,175 @@ const file_goload_proto_rawDesc = "" +
 	"\x05limit\x18\x02 \x01(\x04B\a\xfaB\x042\x02\x18dR\x05limit\"\x9c\x01\n" +
 	"\x1bGetDownloadTaskListResponse\x12B\n" +
 	"\x12download_task_list\x18\x01 \x03(\v2\x14.goload.DownloadTaskR\x10downloadTaskList\x129\n" +
-	"\x19total_download_task_count\x18\x02 \x01(\x04R\x16totalDownloadTaskCount\"\x91\x01\n" +
+	"\x19total_download_task_count\x18\x02 \x01(\x04R\x16totalDownloadTaskCount\"\x94\x01\n" +
 	"\x19UpdateDownloadTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
-	"\x03url\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\x03url\x12H\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\x03url\x18\x02 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\x88\x01\x01R\x03url\x12H\n" +
 	"\x14download_task_status\x18\x03 \x01(\x0e2\x16.goload.DownloadStatusR\x12downloadTaskStatus\"6\n" +
 	"\x1aUpdateDownloadTaskResponse\x12\x18\n" +
 	"\aupdated\x18\x01 \x01(\bR\aupdated\"+\n" +
 	"\x19DeleteDownloadTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"6\n" +
 	"\x1aDeleteDownloadTaskResponse\x12\x18\n" +
-	"\adeleted\x18\x01 \x01(\bR\adeleted\"F\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"+\n" +
+	"\x19CancelDownloadTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"8\n" +
+	"\x1aCancelDownloadTaskResponse\x12\x1a\n" +
+	"\bcanceled\x18\x01 \x01(\bR\bcanceled\"*\n" +
+	"\x18PauseDownloadTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"3\n" +
+	"\x19PauseDownloadTaskResponse\x12\x16\n" +
+	"\x06paused\x18\x01 \x01(\bR\x06paused\"+\n" +
+	"\x19ResumeDownloadTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"6\n" +
+	"\x1aResumeDownloadTaskResponse\x12\x18\n" +
+	"\aresumed\x18\x01 \x01(\bR\aresumed\"F\n" +
 	"\x1aGetDownloadTaskFileRequest\x12(\n" +
 	"\x10download_task_id\x18\x02 \x01(\x04R\x0edownloadTaskId\"1\n" +
 	"\x1bGetDownloadTaskFileResponse\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data*+\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"*\n" +
+	"\x18WatchDownloadTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"V\n" +
+	"\x19WatchDownloadTaskResponse\x129\n" +
+	"\rdownload_task\x18\x01 \x01(\v2\x14.goload.DownloadTaskR\fdownloadTask\"|\n" +
+	"\x1fAdminGetDownloadTaskListRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x04R\x06offset\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x04B\a\xfaB\x042\x02\x18dR\x05limit\x12\"\n" +
+	"\rof_account_id\x18\x03 \x01(\x04R\vofAccountId\"\xa1\x01\n" +
+	" AdminGetDownloadTaskListResponse\x12B\n" +
+	"\x12download_task_list\x18\x01 \x03(\v2\x14.goload.DownloadTaskR\x10downloadTaskList\x129\n" +
+	"\x19total_download_task_count\x18\x02 \x01(\x04R\x16totalDownloadTaskCount\"P\n" +
+	"\x1cAdminFailDownloadTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x06reason\"7\n" +
+	"\x1dAdminFailDownloadTaskResponse\x12\x16\n" +
+	"\x06failed\x18\x01 \x01(\bR\x06failed\"/\n" +
+	"\x1dAdminRetryDownloadTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\":\n" +
+	"\x1eAdminRetryDownloadTaskResponse\x12\x18\n" +
+	"\aretried\x18\x01 \x01(\bR\aretried\",\n" +
+	"\x1aAdminDisableAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"9\n" +
+	"\x1bAdminDisableAccountResponse\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\"[\n" +
+	"\x1dAdminUpdateAccountRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12*\n" +
+	"\x04role\x18\x02 \x01(\x0e2\f.goload.RoleB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04role\":\n" +
+	"\x1eAdminUpdateAccountRoleResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\bR\aupdated\"{\n" +
+	"$AdminUpdateAccountMaxFileSizeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12C\n" +
+	"\x16max_file_size_in_bytes\x18\x02 \x01(\x04B\x0f\xfaB\f2\n" +
+	"\x18\xff\xff\xff\xff\xff\xff\xff\xff\x7fR\x12maxFileSizeInBytes\"A\n" +
+	"%AdminUpdateAccountMaxFileSizeResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\bR\aupdated\"\xb3\x02\n" +
+	"\tUrlPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\"\n" +
+	"\rof_account_id\x18\x02 \x01(\x04R\vofAccountId\x12/\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x17.goload.UrlPolicyActionR\x06action\x12\x1b\n" +
+	"\thost_glob\x18\x04 \x01(\tR\bhostGlob\x12\x1b\n" +
+	"\turl_regex\x18\x05 \x01(\tR\burlRegex\x12\x16\n" +
+	"\x06scheme\x18\x06 \x01(\tR\x06scheme\x12\x12\n" +
+	"\x04port\x18\a \x01(\rR\x04port\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc3\x02\n" +
+	"\x1bAdminCreateUrlPolicyRequest\x12\"\n" +
+	"\rof_account_id\x18\x01 \x01(\x04R\vofAccountId\x129\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x17.goload.UrlPolicyActionB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06action\x12%\n" +
+	"\thost_glob\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\bhostGlob\x12%\n" +
+	"\turl_regex\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\burlRegex\x12,\n" +
+	"\x06scheme\x18\x05 \x01(\tB\x14\xfaB\x11r\x0fR\x00R\x04httpR\x05httpsR\x06scheme\x12\x1d\n" +
+	"\x04port\x18\x06 \x01(\rB\t\xfaB\x06*\x04\x18\xff\xff\x03R\x04port\x12*\n" +
+	"\vdescription\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\vdescription\"P\n" +
+	"\x1cAdminCreateUrlPolicyResponse\x120\n" +
+	"\n" +
+	"url_policy\x18\x01 \x01(\v2\x11.goload.UrlPolicyR\turlPolicy\"y\n" +
+	"\x1cAdminGetUrlPolicyListRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x04R\x06offset\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x04B\a\xfaB\x042\x02\x18dR\x05limit\x12\"\n" +
+	"\rof_account_id\x18\x03 \x01(\x04R\vofAccountId\"\x8f\x01\n" +
+	"\x1dAdminGetUrlPolicyListResponse\x129\n" +
+	"\x0furl_policy_list\x18\x01 \x03(\v2\x11.goload.UrlPolicyR\rurlPolicyList\x123\n" +
+	"\x16total_url_policy_count\x18\x02 \x01(\x04R\x13totalUrlPolicyCount\"\xaf\x02\n" +
+	"\x1bAdminUpdateUrlPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x17.goload.UrlPolicyActionB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06action\x12%\n" +
+	"\thost_glob\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\bhostGlob\x12%\n" +
+	"\turl_regex\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x04R\burlRegex\x12,\n" +
+	"\x06scheme\x18\x05 \x01(\tB\x14\xfaB\x11r\x0fR\x00R\x04httpR\x05httpsR\x06scheme\x12\x1d\n" +
+	"\x04port\x18\x06 \x01(\rB\t\xfaB\x06*\x04\x18\xff\xff\x03R\x04port\x12*\n" +
+	"\vdescription\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\vdescription\"P\n" +
+	"\x1cAdminUpdateUrlPolicyResponse\x120\n" +
+	"\n" +
+	"url_policy\x18\x01 \x01(\v2\x11.goload.UrlPolicyR\turlPolicy\"-\n" +
+	"\x1bAdminDeleteUrlPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"8\n" +
+	"\x1cAdminDeleteUrlPolicyResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted*+\n" +
 	"\fDownloadType\x12\x11\n" +
 	"\rUndefinedType\x10\x00\x12\b\n" +
-	"\x04HTTP\x10\x01*\\\n" +
+	"\x04HTTP\x10\x01*v\n" +
 	"\x0eDownloadStatus\x12\x13\n" +
 	"\x0fUndefinedStatus\x10\x00\x12\v\n" +
 	"\aPending\x10\x01\x12\x0f\n" +
 	"\vDownloading\x10\x02\x12\n" +
 	"\n" +
 	"\x06Failed\x10\x03\x12\v\n" +
-	"\aSuccess\x10\x042\xb8\x06\n" +
+	"\aSuccess\x10\x04\x12\f\n" +
+	"\bCanceled\x10\x05\x12\n" +
+	"\n" +
+	"\x06Paused\x10\x06*V\n" +
+	"\vApiKeyScope\x12\x18\n" +
+	"\x14UndefinedApiKeyScope\x10\x00\x12\x15\n" +
+	"\x11ReadDownloadTasks\x10\x01\x12\x16\n" +
+	"\x12WriteDownloadTasks\x10\x02*<\n" +
+	"\x04Role\x12\x11\n" +
+	"\rUndefinedRole\x10\x00\x12\b\n" +
+	"\x04User\x10\x01\x12\f\n" +
+	"\bOperator\x10\x02\x12\t\n" +
+	"\x05Admin\x10\x03*D\n" +
+	"\x0fUrlPolicyAction\x12\x1c\n" +
+	"\x18UndefinedUrlPolicyAction\x10\x00\x12\t\n" +
+	"\x05Allow\x10\x01\x12\b\n" +
+	"\x04Deny\x10\x02*R\n" +
+	"\x11ChecksumAlgorithm\x12\x1e\n" +
+	"\x1aUndefinedChecksumAlgorithm\x10\x00\x12\a\n" +
+	"\x03MD5\x10\x01\x12\b\n" +
+	"\x04SHA1\x10\x02\x12\n" +
+	"\n" +
+	"\x06SHA256\x10\x032\xe2\x1d\n" +
 	"\rGoLoadService\x12e\n" +
 	"\rCreateAccount\x12\x1c.goload.CreateAccountRequest\x1a\x1d.goload.CreateAccountResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/accounts\x12e\n" +
-	"\rCreateSession\x12\x1c.goload.CreateSessionRequest\x1a\x1d.goload.CreateSessionResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/sessions\x12z\n" +
+	"\rCreateSession\x12\x1c.goload.CreateSessionRequest\x1a\x1d.goload.CreateSessionResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/sessions\x12p\n" +
+	"\x0eRefreshSession\x12\x1d.goload.RefreshSessionRequest\x1a\x1e.goload.RefreshSessionResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/sessions/refresh\x12l\n" +
+	"\rDeleteSession\x12\x1c.goload.DeleteSessionRequest\x1a\x1d.goload.DeleteSessionResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/sessions/logout\x12q\n" +
+	"\x0eChangePassword\x12\x1d.goload.ChangePasswordRequest\x1a\x1e.goload.ChangePasswordResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/accounts/password\x12\x91\x01\n" +
+	"\x14RequestPasswordReset\x12#.goload.RequestPasswordResetRequest\x1a$.goload.RequestPasswordResetResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/accounts/password/reset-request\x12t\n" +
+	"\rResetPassword\x12\x1c.goload.ResetPasswordRequest\x1a\x1d.goload.ResetPasswordResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/accounts/password/reset\x12n\n" +
+	"\x0fGetAccountUsage\x12\x1e.goload.GetAccountUsageRequest\x1a\x1f.goload.GetAccountUsageResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/accounts/usage\x12\x8f\x01\n" +
+	"\x18AdminGetDownloadTaskList\x12'.goload.AdminGetDownloadTaskListRequest\x1a(.goload.AdminGetDownloadTaskListResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/admin/download-tasks\x12\x93\x01\n" +
+	"\x15AdminFailDownloadTask\x12$.goload.AdminFailDownloadTaskRequest\x1a%.goload.AdminFailDownloadTaskResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/admin/download-tasks/{id}/fail\x12\x97\x01\n" +
+	"\x16AdminRetryDownloadTask\x12%.goload.AdminRetryDownloadTaskRequest\x1a&.goload.AdminRetryDownloadTaskResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/admin/download-tasks/{id}/retry\x12\x8a\x01\n" +
+	"\x13AdminDisableAccount\x12\".goload.AdminDisableAccountRequest\x1a#.goload.AdminDisableAccountResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/admin/accounts/{id}/disable\x12\x90\x01\n" +
+	"\x16AdminUpdateAccountRole\x12%.goload.AdminUpdateAccountRoleRequest\x1a&.goload.AdminUpdateAccountRoleResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/admin/accounts/{id}/role\x12\xae\x01\n" +
+	"\x1dAdminUpdateAccountMaxFileSize\x12,.goload.AdminUpdateAccountMaxFileSizeRequest\x1a-.goload.AdminUpdateAccountMaxFileSizeResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/admin/accounts/{id}/max-file-size\x12\x84\x01\n" +
+	"\x14AdminCreateUrlPolicy\x12#.goload.AdminCreateUrlPolicyRequest\x1a$.goload.AdminCreateUrlPolicyResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/admin/url-policies\x12\x84\x01\n" +
+	"\x15AdminGetUrlPolicyList\x12$.goload.AdminGetUrlPolicyListRequest\x1a%.goload.AdminGetUrlPolicyListResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/admin/url-policies\x12\x89\x01\n" +
+	"\x14AdminUpdateUrlPolicy\x12#.goload.AdminUpdateUrlPolicyRequest\x1a$.goload.AdminUpdateUrlPolicyResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/v1/admin/url-policies/{id}\x12\x86\x01\n" +
+	"\x14AdminDeleteUrlPolicy\x12#.goload.AdminDeleteUrlPolicyRequest\x1a$.goload.AdminDeleteUrlPolicyResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/admin/url-policies/{id}\x12b\n" +
+	"\fCreateApiKey\x12\x1b.goload.CreateApiKeyRequest\x1a\x1c.goload.CreateApiKeyResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/api-keys\x12\\\n" +
+	"\vListApiKeys\x12\x1a.goload.ListApiKeysRequest\x1a\x1b.goload.ListApiKeysResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/api-keys\x12n\n" +
+	"\fRevokeApiKey\x12\x1b.goload.RevokeApiKeyRequest\x1a\x1c.goload.RevokeApiKeyResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/api-keys/{id}/revoke\x12z\n" +
 	"\x12CreateDownloadTask\x12!.goload.CreateDownloadTaskRequest\x1a\".goload.CreateDownloadTaskResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/download-tasks\x12z\n" +
 	"\x13GetDownloadTaskList\x12\".goload.GetDownloadTaskListRequest\x1a#.goload.GetDownloadTaskListResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/download-tasks\x12\x7f\n" +
 	"\x12UpdateDownloadTask\x12!.goload.UpdateDownloadTaskRequest\x1a\".goload.UpdateDownloadTaskResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/download-tasks/{id}\x12|\n" +
-	"\x12DeleteDownloadTask\x12!.goload.DeleteDownloadTaskRequest\x1a\".goload.DeleteDownloadTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/download-tasks/{id}\x12b\n" +
-	"\x13GetDownloadTaskFile\x12\".goload.GetDownloadTaskFileRequest\x1a#.goload.GetDownloadTaskFileResponse\"\x000\x01B\x14Z\x12grpc/goload;goloadb\x06proto3"
+	"\x12DeleteDownloadTask\x12!.goload.DeleteDownloadTaskRequest\x1a\".goload.DeleteDownloadTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/download-tasks/{id}\x12\x86\x01\n" +
+	"\x12CancelDownloadTask\x12!.goload.CancelDownloadTaskRequest\x1a\".goload.CancelDownloadTaskResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/download-tasks/{id}/cancel\x12\x82\x01\n" +
+	"\x11PauseDownloadTask\x12 .goload.PauseDownloadTaskRequest\x1a!.goload.PauseDownloadTaskResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/download-tasks/{id}/pause\x12\x86\x01\n" +
+	"\x12ResumeDownloadTask\x12!.goload.ResumeDownloadTaskRequest\x1a\".goload.ResumeDownloadTaskResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/download-tasks/{id}/resume\x12b\n" +
+	"\x13GetDownloadTaskFile\x12\".goload.GetDownloadTaskFileRequest\x1a#.goload.GetDownloadTaskFileResponse\"\x000\x01\x12\\\n" +
+	"\x11WatchDownloadTask\x12 .goload.WatchDownloadTaskRequest\x1a!.goload.WatchDownloadTaskResponse\"\x000\x01B\x14Z\x12grpc/goload;goloadb\x06proto3"
 
 var (
 	file_goload_proto_rawDescOnce sync.Once
@@ -1006,55 +4203,183 @@ func file_goload_proto_rawDescGZIP() []byte {
 	return file_goload_proto_rawDescData
 }
 
-var file_goload_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_goload_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_goload_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_goload_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_goload_proto_goTypes = []any{
-	(DownloadType)(0),                   // 0: goload.DownloadType
-	(DownloadStatus)(0),                 // 1: goload.DownloadStatus
-	(*Account)(nil),                     // 2: goload.Account
-	(*DownloadTask)(nil),                // 3: goload.DownloadTask
-	(*CreateAccountRequest)(nil),        // 4: goload.CreateAccountRequest
-	(*CreateAccountResponse)(nil),       // 5: goload.CreateAccountResponse
-	(*CreateSessionRequest)(nil),        // 6: goload.CreateSessionRequest
-	(*CreateSessionResponse)(nil),       // 7: goload.CreateSessionResponse
-	(*CreateDownloadTaskRequest)(nil),   // 8: goload.CreateDownloadTaskRequest
-	(*CreateDownloadTaskResponse)(nil),  // 9: goload.CreateDownloadTaskResponse
-	(*GetDownloadTaskListRequest)(nil),  // 10: goload.GetDownloadTaskListRequest
-	(*GetDownloadTaskListResponse)(nil), // 11: goload.GetDownloadTaskListResponse
-	(*UpdateDownloadTaskRequest)(nil),   // 12: goload.UpdateDownloadTaskRequest
-	(*UpdateDownloadTaskResponse)(nil),  // 13: goload.UpdateDownloadTaskResponse
-	(*DeleteDownloadTaskRequest)(nil),   // 14: goload.DeleteDownloadTaskRequest
-	(*DeleteDownloadTaskResponse)(nil),  // 15: goload.DeleteDownloadTaskResponse
-	(*GetDownloadTaskFileRequest)(nil),  // 16: goload.GetDownloadTaskFileRequest
-	(*GetDownloadTaskFileResponse)(nil), // 17: goload.GetDownloadTaskFileResponse
+	(DownloadType)(0),                             // 0: goload.DownloadType
+	(DownloadStatus)(0),                           // 1: goload.DownloadStatus
+	(ApiKeyScope)(0),                              // 2: goload.ApiKeyScope
+	(Role)(0),                                     // 3: goload.Role
+	(UrlPolicyAction)(0),                          // 4: goload.UrlPolicyAction
+	(ChecksumAlgorithm)(0),                        // 5: goload.ChecksumAlgorithm
+	(*Account)(nil),                               // 6: goload.Account
+	(*DownloadProgress)(nil),                      // 7: goload.DownloadProgress
+	(*Checksum)(nil),                              // 8: goload.Checksum
+	(*DownloadTask)(nil),                          // 9: goload.DownloadTask
+	(*CreateAccountRequest)(nil),                  // 10: goload.CreateAccountRequest
+	(*CreateAccountResponse)(nil),                 // 11: goload.CreateAccountResponse
+	(*CreateSessionRequest)(nil),                  // 12: goload.CreateSessionRequest
+	(*CreateSessionResponse)(nil),                 // 13: goload.CreateSessionResponse
+	(*RefreshSessionRequest)(nil),                 // 14: goload.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),                // 15: goload.RefreshSessionResponse
+	(*DeleteSessionRequest)(nil),                  // 16: goload.DeleteSessionRequest
+	(*DeleteSessionResponse)(nil),                 // 17: goload.DeleteSessionResponse
+	(*ChangePasswordRequest)(nil),                 // 18: goload.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),                // 19: goload.ChangePasswordResponse
+	(*RequestPasswordResetRequest)(nil),           // 20: goload.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),          // 21: goload.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),                  // 22: goload.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),                 // 23: goload.ResetPasswordResponse
+	(*AccountUsage)(nil),                          // 24: goload.AccountUsage
+	(*GetAccountUsageRequest)(nil),                // 25: goload.GetAccountUsageRequest
+	(*GetAccountUsageResponse)(nil),               // 26: goload.GetAccountUsageResponse
+	(*ApiKey)(nil),                                // 27: goload.ApiKey
+	(*CreateApiKeyRequest)(nil),                   // 28: goload.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),                  // 29: goload.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),                    // 30: goload.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),                   // 31: goload.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),                   // 32: goload.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),                  // 33: goload.RevokeApiKeyResponse
+	(*CreateDownloadTaskRequest)(nil),             // 34: goload.CreateDownloadTaskRequest
+	(*CreateDownloadTaskResponse)(nil),            // 35: goload.CreateDownloadTaskResponse
+	(*GetDownloadTaskListRequest)(nil),            // 36: goload.GetDownloadTaskListRequest
+	(*GetDownloadTaskListResponse)(nil),           // 37: goload.GetDownloadTaskListResponse
+	(*UpdateDownloadTaskRequest)(nil),             // 38: goload.UpdateDownloadTaskRequest
+	(*UpdateDownloadTaskResponse)(nil),            // 39: goload.UpdateDownloadTaskResponse
+	(*DeleteDownloadTaskRequest)(nil),             // 40: goload.DeleteDownloadTaskRequest
+	(*DeleteDownloadTaskResponse)(nil),            // 41: goload.DeleteDownloadTaskResponse
+	(*CancelDownloadTaskRequest)(nil),             // 42: goload.CancelDownloadTaskRequest
+	(*CancelDownloadTaskResponse)(nil),            // 43: goload.CancelDownloadTaskResponse
+	(*PauseDownloadTaskRequest)(nil),              // 44: goload.PauseDownloadTaskRequest
+	(*PauseDownloadTaskResponse)(nil),             // 45: goload.PauseDownloadTaskResponse
+	(*ResumeDownloadTaskRequest)(nil),             // 46: goload.ResumeDownloadTaskRequest
+	(*ResumeDownloadTaskResponse)(nil),            // 47: goload.ResumeDownloadTaskResponse
+	(*GetDownloadTaskFileRequest)(nil),            // 48: goload.GetDownloadTaskFileRequest
+	(*GetDownloadTaskFileResponse)(nil),           // 49: goload.GetDownloadTaskFileResponse
+	(*WatchDownloadTaskRequest)(nil),              // 50: goload.WatchDownloadTaskRequest
+	(*WatchDownloadTaskResponse)(nil),             // 51: goload.WatchDownloadTaskResponse
+	(*AdminGetDownloadTaskListRequest)(nil),       // 52: goload.AdminGetDownloadTaskListRequest
+	(*AdminGetDownloadTaskListResponse)(nil),      // 53: goload.AdminGetDownloadTaskListResponse
+	(*AdminFailDownloadTaskRequest)(nil),          // 54: goload.AdminFailDownloadTaskRequest
+	(*AdminFailDownloadTaskResponse)(nil),         // 55: goload.AdminFailDownloadTaskResponse
+	(*AdminRetryDownloadTaskRequest)(nil),         // 56: goload.AdminRetryDownloadTaskRequest
+	(*AdminRetryDownloadTaskResponse)(nil),        // 57: goload.AdminRetryDownloadTaskResponse
+	(*AdminDisableAccountRequest)(nil),            // 58: goload.AdminDisableAccountRequest
+	(*AdminDisableAccountResponse)(nil),           // 59: goload.AdminDisableAccountResponse
+	(*AdminUpdateAccountRoleRequest)(nil),         // 60: goload.AdminUpdateAccountRoleRequest
+	(*AdminUpdateAccountRoleResponse)(nil),        // 61: goload.AdminUpdateAccountRoleResponse
+	(*AdminUpdateAccountMaxFileSizeRequest)(nil),  // 62: goload.AdminUpdateAccountMaxFileSizeRequest
+	(*AdminUpdateAccountMaxFileSizeResponse)(nil), // 63: goload.AdminUpdateAccountMaxFileSizeResponse
+	(*UrlPolicy)(nil),                             // 64: goload.UrlPolicy
+	(*AdminCreateUrlPolicyRequest)(nil),           // 65: goload.AdminCreateUrlPolicyRequest
+	(*AdminCreateUrlPolicyResponse)(nil),          // 66: goload.AdminCreateUrlPolicyResponse
+	(*AdminGetUrlPolicyListRequest)(nil),          // 67: goload.AdminGetUrlPolicyListRequest
+	(*AdminGetUrlPolicyListResponse)(nil),         // 68: goload.AdminGetUrlPolicyListResponse
+	(*AdminUpdateUrlPolicyRequest)(nil),           // 69: goload.AdminUpdateUrlPolicyRequest
+	(*AdminUpdateUrlPolicyResponse)(nil),          // 70: goload.AdminUpdateUrlPolicyResponse
+	(*AdminDeleteUrlPolicyRequest)(nil),           // 71: goload.AdminDeleteUrlPolicyRequest
+	(*AdminDeleteUrlPolicyResponse)(nil),          // 72: goload.AdminDeleteUrlPolicyResponse
+	(*timestamppb.Timestamp)(nil),                 // 73: google.protobuf.Timestamp
 }
 var file_goload_proto_depIdxs = []int32{
-	2,  // 0: goload.DownloadTask.of_account:type_name -> goload.Account
-	0,  // 1: goload.DownloadTask.download_type:type_name -> goload.DownloadType
-	1,  // 2: goload.DownloadTask.download_status:type_name -> goload.DownloadStatus
-	2,  // 3: goload.CreateSessionResponse.account:type_name -> goload.Account
-	3,  // 4: goload.CreateDownloadTaskResponse.download_task:type_name -> goload.DownloadTask
-	3,  // 5: goload.GetDownloadTaskListResponse.download_task_list:type_name -> goload.DownloadTask
-	1,  // 6: goload.UpdateDownloadTaskRequest.download_task_status:type_name -> goload.DownloadStatus
-	4,  // 7: goload.GoLoadService.CreateAccount:input_type -> goload.CreateAccountRequest
-	6,  // 8: goload.GoLoadService.CreateSession:input_type -> goload.CreateSessionRequest
-	8,  // 9: goload.GoLoadService.CreateDownloadTask:input_type -> goload.CreateDownloadTaskRequest
-	10, // 10: goload.GoLoadService.GetDownloadTaskList:input_type -> goload.GetDownloadTaskListRequest
-	12, // 11: goload.GoLoadService.UpdateDownloadTask:input_type -> goload.UpdateDownloadTaskRequest
-	14, // 12: goload.GoLoadService.DeleteDownloadTask:input_type -> goload.DeleteDownloadTaskRequest
-	16, // 13: goload.GoLoadService.GetDownloadTaskFile:input_type -> goload.GetDownloadTaskFileRequest
-	5,  // 14: goload.GoLoadService.CreateAccount:output_type -> goload.CreateAccountResponse
-	7,  // 15: goload.GoLoadService.CreateSession:output_type -> goload.CreateSessionResponse
-	9,  // 16: goload.GoLoadService.CreateDownloadTask:output_type -> goload.CreateDownloadTaskResponse
-	11, // 17: goload.GoLoadService.GetDownloadTaskList:output_type -> goload.GetDownloadTaskListResponse
-	13, // 18: goload.GoLoadService.UpdateDownloadTask:output_type -> goload.UpdateDownloadTaskResponse
-	15, // 19: goload.GoLoadService.DeleteDownloadTask:output_type -> goload.DeleteDownloadTaskResponse
-	17, // 20: goload.GoLoadService.GetDownloadTaskFile:output_type -> goload.GetDownloadTaskFileResponse
-	14, // [14:21] is the sub-list for method output_type
-	7,  // [7:14] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	3,  // 0: goload.Account.role:type_name -> goload.Role
+	5,  // 1: goload.Checksum.algorithm:type_name -> goload.ChecksumAlgorithm
+	6,  // 2: goload.DownloadTask.of_account:type_name -> goload.Account
+	0,  // 3: goload.DownloadTask.download_type:type_name -> goload.DownloadType
+	1,  // 4: goload.DownloadTask.download_status:type_name -> goload.DownloadStatus
+	7,  // 5: goload.DownloadTask.progress:type_name -> goload.DownloadProgress
+	8,  // 6: goload.DownloadTask.checksums:type_name -> goload.Checksum
+	73, // 7: goload.DownloadTask.created_at:type_name -> google.protobuf.Timestamp
+	73, // 8: goload.DownloadTask.updated_at:type_name -> google.protobuf.Timestamp
+	73, // 9: goload.DownloadTask.completed_at:type_name -> google.protobuf.Timestamp
+	6,  // 10: goload.CreateSessionResponse.account:type_name -> goload.Account
+	24, // 11: goload.GetAccountUsageResponse.account_usage:type_name -> goload.AccountUsage
+	2,  // 12: goload.ApiKey.scopes:type_name -> goload.ApiKeyScope
+	73, // 13: goload.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	73, // 14: goload.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 15: goload.CreateApiKeyRequest.scopes:type_name -> goload.ApiKeyScope
+	73, // 16: goload.CreateApiKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	27, // 17: goload.CreateApiKeyResponse.api_key:type_name -> goload.ApiKey
+	27, // 18: goload.ListApiKeysResponse.api_key_list:type_name -> goload.ApiKey
+	8,  // 19: goload.CreateDownloadTaskRequest.expected_checksum:type_name -> goload.Checksum
+	9,  // 20: goload.CreateDownloadTaskResponse.download_task:type_name -> goload.DownloadTask
+	9,  // 21: goload.GetDownloadTaskListResponse.download_task_list:type_name -> goload.DownloadTask
+	1,  // 22: goload.UpdateDownloadTaskRequest.download_task_status:type_name -> goload.DownloadStatus
+	9,  // 23: goload.WatchDownloadTaskResponse.download_task:type_name -> goload.DownloadTask
+	9,  // 24: goload.AdminGetDownloadTaskListResponse.download_task_list:type_name -> goload.DownloadTask
+	3,  // 25: goload.AdminUpdateAccountRoleRequest.role:type_name -> goload.Role
+	4,  // 26: goload.UrlPolicy.action:type_name -> goload.UrlPolicyAction
+	73, // 27: goload.UrlPolicy.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 28: goload.AdminCreateUrlPolicyRequest.action:type_name -> goload.UrlPolicyAction
+	64, // 29: goload.AdminCreateUrlPolicyResponse.url_policy:type_name -> goload.UrlPolicy
+	64, // 30: goload.AdminGetUrlPolicyListResponse.url_policy_list:type_name -> goload.UrlPolicy
+	4,  // 31: goload.AdminUpdateUrlPolicyRequest.action:type_name -> goload.UrlPolicyAction
+	64, // 32: goload.AdminUpdateUrlPolicyResponse.url_policy:type_name -> goload.UrlPolicy
+	10, // 33: goload.GoLoadService.CreateAccount:input_type -> goload.CreateAccountRequest
+	12, // 34: goload.GoLoadService.CreateSession:input_type -> goload.CreateSessionRequest
+	14, // 35: goload.GoLoadService.RefreshSession:input_type -> goload.RefreshSessionRequest
+	16, // 36: goload.GoLoadService.DeleteSession:input_type -> goload.DeleteSessionRequest
+	18, // 37: goload.GoLoadService.ChangePassword:input_type -> goload.ChangePasswordRequest
+	20, // 38: goload.GoLoadService.RequestPasswordReset:input_type -> goload.RequestPasswordResetRequest
+	22, // 39: goload.GoLoadService.ResetPassword:input_type -> goload.ResetPasswordRequest
+	25, // 40: goload.GoLoadService.GetAccountUsage:input_type -> goload.GetAccountUsageRequest
+	52, // 41: goload.GoLoadService.AdminGetDownloadTaskList:input_type -> goload.AdminGetDownloadTaskListRequest
+	54, // 42: goload.GoLoadService.AdminFailDownloadTask:input_type -> goload.AdminFailDownloadTaskRequest
+	56, // 43: goload.GoLoadService.AdminRetryDownloadTask:input_type -> goload.AdminRetryDownloadTaskRequest
+	58, // 44: goload.GoLoadService.AdminDisableAccount:input_type -> goload.AdminDisableAccountRequest
+	60, // 45: goload.GoLoadService.AdminUpdateAccountRole:input_type -> goload.AdminUpdateAccountRoleRequest
+	62, // 46: goload.GoLoadService.AdminUpdateAccountMaxFileSize:input_type -> goload.AdminUpdateAccountMaxFileSizeRequest
+	65, // 47: goload.GoLoadService.AdminCreateUrlPolicy:input_type -> goload.AdminCreateUrlPolicyRequest
+	67, // 48: goload.GoLoadService.AdminGetUrlPolicyList:input_type -> goload.AdminGetUrlPolicyListRequest
+	69, // 49: goload.GoLoadService.AdminUpdateUrlPolicy:input_type -> goload.AdminUpdateUrlPolicyRequest
+	71, // 50: goload.GoLoadService.AdminDeleteUrlPolicy:input_type -> goload.AdminDeleteUrlPolicyRequest
+	28, // 51: goload.GoLoadService.CreateApiKey:input_type -> goload.CreateApiKeyRequest
+	30, // 52: goload.GoLoadService.ListApiKeys:input_type -> goload.ListApiKeysRequest
+	32, // 53: goload.GoLoadService.RevokeApiKey:input_type -> goload.RevokeApiKeyRequest
+	34, // 54: goload.GoLoadService.CreateDownloadTask:input_type -> goload.CreateDownloadTaskRequest
+	36, // 55: goload.GoLoadService.GetDownloadTaskList:input_type -> goload.GetDownloadTaskListRequest
+	38, // 56: goload.GoLoadService.UpdateDownloadTask:input_type -> goload.UpdateDownloadTaskRequest
+	40, // 57: goload.GoLoadService.DeleteDownloadTask:input_type -> goload.DeleteDownloadTaskRequest
+	42, // 58: goload.GoLoadService.CancelDownloadTask:input_type -> goload.CancelDownloadTaskRequest
+	44, // 59: goload.GoLoadService.PauseDownloadTask:input_type -> goload.PauseDownloadTaskRequest
+	46, // 60: goload.GoLoadService.ResumeDownloadTask:input_type -> goload.ResumeDownloadTaskRequest
+	48, // 61: goload.GoLoadService.GetDownloadTaskFile:input_type -> goload.GetDownloadTaskFileRequest
+	50, // 62: goload.GoLoadService.WatchDownloadTask:input_type -> goload.WatchDownloadTaskRequest
+	11, // 63: goload.GoLoadService.CreateAccount:output_type -> goload.CreateAccountResponse
+	13, // 64: goload.GoLoadService.CreateSession:output_type -> goload.CreateSessionResponse
+	15, // 65: goload.GoLoadService.RefreshSession:output_type -> goload.RefreshSessionResponse
+	17, // 66: goload.GoLoadService.DeleteSession:output_type -> goload.DeleteSessionResponse
+	19, // 67: goload.GoLoadService.ChangePassword:output_type -> goload.ChangePasswordResponse
+	21, // 68: goload.GoLoadService.RequestPasswordReset:output_type -> goload.RequestPasswordResetResponse
+	23, // 69: goload.GoLoadService.ResetPassword:output_type -> goload.ResetPasswordResponse
+	26, // 70: goload.GoLoadService.GetAccountUsage:output_type -> goload.GetAccountUsageResponse
+	53, // 71: goload.GoLoadService.AdminGetDownloadTaskList:output_type -> goload.AdminGetDownloadTaskListResponse
+	55, // 72: goload.GoLoadService.AdminFailDownloadTask:output_type -> goload.AdminFailDownloadTaskResponse
+	57, // 73: goload.GoLoadService.AdminRetryDownloadTask:output_type -> goload.AdminRetryDownloadTaskResponse
+	59, // 74: goload.GoLoadService.AdminDisableAccount:output_type -> goload.AdminDisableAccountResponse
+	61, // 75: goload.GoLoadService.AdminUpdateAccountRole:output_type -> goload.AdminUpdateAccountRoleResponse
+	63, // 76: goload.GoLoadService.AdminUpdateAccountMaxFileSize:output_type -> goload.AdminUpdateAccountMaxFileSizeResponse
+	66, // 77: goload.GoLoadService.AdminCreateUrlPolicy:output_type -> goload.AdminCreateUrlPolicyResponse
+	68, // 78: goload.GoLoadService.AdminGetUrlPolicyList:output_type -> goload.AdminGetUrlPolicyListResponse
+	70, // 79: goload.GoLoadService.AdminUpdateUrlPolicy:output_type -> goload.AdminUpdateUrlPolicyResponse
+	72, // 80: goload.GoLoadService.AdminDeleteUrlPolicy:output_type -> goload.AdminDeleteUrlPolicyResponse
+	29, // 81: goload.GoLoadService.CreateApiKey:output_type -> goload.CreateApiKeyResponse
+	31, // 82: goload.GoLoadService.ListApiKeys:output_type -> goload.ListApiKeysResponse
+	33, // 83: goload.GoLoadService.RevokeApiKey:output_type -> goload.RevokeApiKeyResponse
+	35, // 84: goload.GoLoadService.CreateDownloadTask:output_type -> goload.CreateDownloadTaskResponse
+	37, // 85: goload.GoLoadService.GetDownloadTaskList:output_type -> goload.GetDownloadTaskListResponse
+	39, // 86: goload.GoLoadService.UpdateDownloadTask:output_type -> goload.UpdateDownloadTaskResponse
+	41, // 87: goload.GoLoadService.DeleteDownloadTask:output_type -> goload.DeleteDownloadTaskResponse
+	43, // 88: goload.GoLoadService.CancelDownloadTask:output_type -> goload.CancelDownloadTaskResponse
+	45, // 89: goload.GoLoadService.PauseDownloadTask:output_type -> goload.PauseDownloadTaskResponse
+	47, // 90: goload.GoLoadService.ResumeDownloadTask:output_type -> goload.ResumeDownloadTaskResponse
+	49, // 91: goload.GoLoadService.GetDownloadTaskFile:output_type -> goload.GetDownloadTaskFileResponse
+	51, // 92: goload.GoLoadService.WatchDownloadTask:output_type -> goload.WatchDownloadTaskResponse
+	63, // [63:93] is the sub-list for method output_type
+	33, // [33:63] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_goload_proto_init() }
@@ -1067,8 +4392,8 @@ func file_goload_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goload_proto_rawDesc), len(file_goload_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   16,
+			NumEnums:      6,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
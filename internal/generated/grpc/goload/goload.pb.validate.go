@@ -60,6 +60,10 @@ func (m *Account) validate(all bool) error {
 
 	// no validation rules for AccountName
 
+	// no validation rules for Role
+
+	// no validation rules for Disabled
+
 	if len(errors) > 0 {
 		return AccountMultiError(errors)
 	}
@@ -137,6 +141,237 @@ var _ interface {
 	ErrorName() string
 } = AccountValidationError{}
 
+// Validate checks the field values on DownloadProgress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DownloadProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadProgressMultiError, or nil if none found.
+func (m *DownloadProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadedBytes
+
+	// no validation rules for TotalBytes
+
+	// no validation rules for BytesPerSecond
+
+	// no validation rules for EtaSeconds
+
+	if len(errors) > 0 {
+		return DownloadProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadProgressMultiError is an error wrapping multiple validation errors
+// returned by DownloadProgress.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadProgressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadProgressMultiError) AllErrors() []error { return m }
+
+// DownloadProgressValidationError is the validation error returned by
+// DownloadProgress.Validate if the designated constraints aren't met.
+type DownloadProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadProgressValidationError) ErrorName() string { return "DownloadProgressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadProgressValidationError{}
+
+// Validate checks the field values on Checksum with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Checksum) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Checksum with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChecksumMultiError, or nil
+// if none found.
+func (m *Checksum) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Checksum) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ChecksumAlgorithm_name[int32(m.GetAlgorithm())]; !ok {
+		err := ChecksumValidationError{
+			field:  "Algorithm",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Checksum_Digest_Pattern.MatchString(m.GetDigest()) {
+		err := ChecksumValidationError{
+			field:  "Digest",
+			reason: "value does not match regex pattern \"^[0-9a-fA-F]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChecksumMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChecksumMultiError is an error wrapping multiple validation errors returned
+// by Checksum.ValidateAll() if the designated constraints aren't met.
+type ChecksumMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChecksumMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChecksumMultiError) AllErrors() []error { return m }
+
+// ChecksumValidationError is the validation error returned by
+// Checksum.Validate if the designated constraints aren't met.
+type ChecksumValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChecksumValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChecksumValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChecksumValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChecksumValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChecksumValidationError) ErrorName() string { return "ChecksumValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChecksumValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChecksum.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChecksumValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChecksumValidationError{}
+
+var _Checksum_Digest_Pattern = regexp.MustCompile("^[0-9a-fA-F]+$")
+
 // Validate checks the field values on DownloadTask with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -190,25 +425,5888 @@ func (m *DownloadTask) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for DownloadType
-
-	// no validation rules for Url
+	// no validation rules for DownloadType
+
+	// no validation rules for Url
+
+	// no validation rules for DownloadStatus
+
+	if all {
+		switch v := interface{}(m.GetProgress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "Progress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChecksums() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskValidationError{
+						field:  fmt.Sprintf("Checksums[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskValidationError{
+						field:  fmt.Sprintf("Checksums[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskValidationError{
+					field:  fmt.Sprintf("Checksums[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FileSize
+
+	// no validation rules for ContentType
+
+	// no validation rules for FileName
+
+	// no validation rules for ErrorMessage
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DownloadTaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskMultiError is an error wrapping multiple validation errors
+// returned by DownloadTask.ValidateAll() if the designated constraints aren't met.
+type DownloadTaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskMultiError) AllErrors() []error { return m }
+
+// DownloadTaskValidationError is the validation error returned by
+// DownloadTask.Validate if the designated constraints aren't met.
+type DownloadTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskValidationError) ErrorName() string { return "DownloadTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskValidationError{}
+
+// Validate checks the field values on CreateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccountRequestMultiError, or nil if none found.
+func (m *CreateAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CreateAccountRequest_AccountName_Pattern.MatchString(m.GetAccountName()) {
+		err := CreateAccountRequestValidationError{
+			field:  "AccountName",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateAccountRequest_Password_Pattern.MatchString(m.GetPassword()) {
+		err := CreateAccountRequestValidationError{
+			field:  "Password",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccountRequestMultiError) AllErrors() []error { return m }
+
+// CreateAccountRequestValidationError is the validation error returned by
+// CreateAccountRequest.Validate if the designated constraints aren't met.
+type CreateAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccountRequestValidationError) ErrorName() string {
+	return "CreateAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccountRequestValidationError{}
+
+var _CreateAccountRequest_AccountName_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+var _CreateAccountRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+// Validate checks the field values on CreateAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccountResponseMultiError, or nil if none found.
+func (m *CreateAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return CreateAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccountResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccountResponseMultiError) AllErrors() []error { return m }
+
+// CreateAccountResponseValidationError is the validation error returned by
+// CreateAccountResponse.Validate if the designated constraints aren't met.
+type CreateAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccountResponseValidationError) ErrorName() string {
+	return "CreateAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccountResponseValidationError{}
+
+// Validate checks the field values on CreateSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSessionRequestMultiError, or nil if none found.
+func (m *CreateSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CreateSessionRequest_AccountName_Pattern.MatchString(m.GetAccountName()) {
+		err := CreateSessionRequestValidationError{
+			field:  "AccountName",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateSessionRequest_Password_Pattern.MatchString(m.GetPassword()) {
+		err := CreateSessionRequestValidationError{
+			field:  "Password",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSessionRequestMultiError) AllErrors() []error { return m }
+
+// CreateSessionRequestValidationError is the validation error returned by
+// CreateSessionRequest.Validate if the designated constraints aren't met.
+type CreateSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSessionRequestValidationError) ErrorName() string {
+	return "CreateSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSessionRequestValidationError{}
+
+var _CreateSessionRequest_AccountName_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+var _CreateSessionRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+// Validate checks the field values on CreateSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSessionResponseMultiError, or nil if none found.
+func (m *CreateSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSessionResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Token
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return CreateSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSessionResponseMultiError) AllErrors() []error { return m }
+
+// CreateSessionResponseValidationError is the validation error returned by
+// CreateSessionResponse.Validate if the designated constraints aren't met.
+type CreateSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSessionResponseValidationError) ErrorName() string {
+	return "CreateSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSessionResponseValidationError{}
+
+// Validate checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionRequestMultiError, or nil if none found.
+func (m *RefreshSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 1 {
+		err := RefreshSessionRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefreshSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionRequestMultiError) AllErrors() []error { return m }
+
+// RefreshSessionRequestValidationError is the validation error returned by
+// RefreshSessionRequest.Validate if the designated constraints aren't met.
+type RefreshSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionRequestValidationError) ErrorName() string {
+	return "RefreshSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionRequestValidationError{}
+
+// Validate checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionResponseMultiError, or nil if none found.
+func (m *RefreshSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return RefreshSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionResponseMultiError) AllErrors() []error { return m }
+
+// RefreshSessionResponseValidationError is the validation error returned by
+// RefreshSessionResponse.Validate if the designated constraints aren't met.
+type RefreshSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionResponseValidationError) ErrorName() string {
+	return "RefreshSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionResponseValidationError{}
+
+// Validate checks the field values on DeleteSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSessionRequestMultiError, or nil if none found.
+func (m *DeleteSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 1 {
+		err := DeleteSessionRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSessionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSessionRequestValidationError is the validation error returned by
+// DeleteSessionRequest.Validate if the designated constraints aren't met.
+type DeleteSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSessionRequestValidationError) ErrorName() string {
+	return "DeleteSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionRequestValidationError{}
+
+// Validate checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSessionResponseMultiError, or nil if none found.
+func (m *DeleteSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return DeleteSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSessionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSessionResponseValidationError is the validation error returned by
+// DeleteSessionResponse.Validate if the designated constraints aren't met.
+type DeleteSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSessionResponseValidationError) ErrorName() string {
+	return "DeleteSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionResponseValidationError{}
+
+// Validate checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ChangePasswordRequest_OldPassword_Pattern.MatchString(m.GetOldPassword()) {
+		err := ChangePasswordRequestValidationError{
+			field:  "OldPassword",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ChangePasswordRequest_NewPassword_Pattern.MatchString(m.GetNewPassword()) {
+		err := ChangePasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
+
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordRequestValidationError{}
+
+var _ChangePasswordRequest_OldPassword_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+var _ChangePasswordRequest_NewPassword_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+// Validate checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordResponseMultiError, or nil if none found.
+func (m *ChangePasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return ChangePasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordResponseMultiError) AllErrors() []error { return m }
+
+// ChangePasswordResponseValidationError is the validation error returned by
+// ChangePasswordResponse.Validate if the designated constraints aren't met.
+type ChangePasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordResponseValidationError) ErrorName() string {
+	return "ChangePasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordResponseValidationError{}
+
+// Validate checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetRequestMultiError, or nil if none found.
+func (m *RequestPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RequestPasswordResetRequest_AccountName_Pattern.MatchString(m.GetAccountName()) {
+		err := RequestPasswordResetRequestValidationError{
+			field:  "AccountName",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetRequestValidationError is the validation error returned
+// by RequestPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetRequestValidationError) ErrorName() string {
+	return "RequestPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetRequestValidationError{}
+
+var _RequestPasswordResetRequest_AccountName_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+// Validate checks the field values on RequestPasswordResetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetResponseMultiError, or nil if none found.
+func (m *RequestPasswordResetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RequestPasswordResetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetResponseMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetResponseMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetResponseValidationError is the validation error returned
+// by RequestPasswordResetResponse.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetResponseValidationError) ErrorName() string {
+	return "RequestPasswordResetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetResponseValidationError{}
+
+// Validate checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordRequestMultiError, or nil if none found.
+func (m *ResetPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetResetToken()) < 1 {
+		err := ResetPasswordRequestValidationError{
+			field:  "ResetToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ResetPasswordRequest_NewPassword_Pattern.MatchString(m.GetNewPassword()) {
+		err := ResetPasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordRequestMultiError) AllErrors() []error { return m }
+
+// ResetPasswordRequestValidationError is the validation error returned by
+// ResetPasswordRequest.Validate if the designated constraints aren't met.
+type ResetPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordRequestValidationError) ErrorName() string {
+	return "ResetPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordRequestValidationError{}
+
+var _ResetPasswordRequest_NewPassword_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+// Validate checks the field values on ResetPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordResponseMultiError, or nil if none found.
+func (m *ResetPasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return ResetPasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordResponseMultiError) AllErrors() []error { return m }
+
+// ResetPasswordResponseValidationError is the validation error returned by
+// ResetPasswordResponse.Validate if the designated constraints aren't met.
+type ResetPasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordResponseValidationError) ErrorName() string {
+	return "ResetPasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordResponseValidationError{}
+
+// Validate checks the field values on AccountUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccountUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccountUsageMultiError, or
+// nil if none found.
+func (m *AccountUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadingTaskCount
+
+	// no validation rules for PendingTaskCount
+
+	// no validation rules for StoredBytes
+
+	// no validation rules for MaxDownloadingTaskCount
+
+	// no validation rules for MaxPendingTaskCount
+
+	// no validation rules for MaxStoredBytes
+
+	// no validation rules for MaxFileSizeInBytes
+
+	if len(errors) > 0 {
+		return AccountUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountUsageMultiError is an error wrapping multiple validation errors
+// returned by AccountUsage.ValidateAll() if the designated constraints aren't met.
+type AccountUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountUsageMultiError) AllErrors() []error { return m }
+
+// AccountUsageValidationError is the validation error returned by
+// AccountUsage.Validate if the designated constraints aren't met.
+type AccountUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountUsageValidationError) ErrorName() string { return "AccountUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountUsageValidationError{}
+
+// Validate checks the field values on GetAccountUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountUsageRequestMultiError, or nil if none found.
+func (m *GetAccountUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetAccountUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAccountUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountUsageRequestValidationError is the validation error returned by
+// GetAccountUsageRequest.Validate if the designated constraints aren't met.
+type GetAccountUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountUsageRequestValidationError) ErrorName() string {
+	return "GetAccountUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountUsageRequestValidationError{}
+
+// Validate checks the field values on GetAccountUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountUsageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountUsageResponseMultiError, or nil if none found.
+func (m *GetAccountUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccountUsage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAccountUsageResponseValidationError{
+					field:  "AccountUsage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAccountUsageResponseValidationError{
+					field:  "AccountUsage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccountUsage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAccountUsageResponseValidationError{
+				field:  "AccountUsage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAccountUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAccountUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountUsageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountUsageResponseValidationError is the validation error returned by
+// GetAccountUsageResponse.Validate if the designated constraints aren't met.
+type GetAccountUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountUsageResponseValidationError) ErrorName() string {
+	return "GetAccountUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountUsageResponseValidationError{}
+
+// Validate checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ApiKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ApiKeyMultiError, or nil if none found.
+func (m *ApiKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Scopes
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Revoked
+
+	if len(errors) > 0 {
+		return ApiKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyMultiError is an error wrapping multiple validation errors returned by
+// ApiKey.ValidateAll() if the designated constraints aren't met.
+type ApiKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyMultiError) AllErrors() []error { return m }
+
+// ApiKeyValidationError is the validation error returned by ApiKey.Validate if
+// the designated constraints aren't met.
+type ApiKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyValidationError) ErrorName() string { return "ApiKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApiKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyValidationError{}
+
+// Validate checks the field values on CreateApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApiKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateApiKeyRequestMultiError, or nil if none found.
+func (m *CreateApiKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApiKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateApiKeyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScopes()) < 1 {
+		err := CreateApiKeyRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApiKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApiKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApiKeyRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateApiKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApiKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateApiKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApiKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApiKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApiKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateApiKeyRequestValidationError is the validation error returned by
+// CreateApiKeyRequest.Validate if the designated constraints aren't met.
+type CreateApiKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApiKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApiKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApiKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApiKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApiKeyRequestValidationError) ErrorName() string {
+	return "CreateApiKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApiKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApiKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApiKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApiKeyRequestValidationError{}
+
+// Validate checks the field values on CreateApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApiKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateApiKeyResponseMultiError, or nil if none found.
+func (m *CreateApiKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApiKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApiKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApiKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApiKeyResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CreateApiKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApiKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateApiKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApiKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApiKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApiKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateApiKeyResponseValidationError is the validation error returned by
+// CreateApiKeyResponse.Validate if the designated constraints aren't met.
+type CreateApiKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApiKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApiKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApiKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApiKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApiKeyResponseValidationError) ErrorName() string {
+	return "CreateApiKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApiKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApiKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApiKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApiKeyResponseValidationError{}
+
+// Validate checks the field values on ListApiKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApiKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApiKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApiKeysRequestMultiError, or nil if none found.
+func (m *ListApiKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApiKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListApiKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApiKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListApiKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListApiKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApiKeysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApiKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListApiKeysRequestValidationError is the validation error returned by
+// ListApiKeysRequest.Validate if the designated constraints aren't met.
+type ListApiKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApiKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApiKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApiKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApiKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApiKeysRequestValidationError) ErrorName() string {
+	return "ListApiKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApiKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApiKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApiKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApiKeysRequestValidationError{}
+
+// Validate checks the field values on ListApiKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApiKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApiKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApiKeysResponseMultiError, or nil if none found.
+func (m *ListApiKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApiKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApiKeyList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListApiKeysResponseValidationError{
+						field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListApiKeysResponseValidationError{
+						field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListApiKeysResponseValidationError{
+					field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListApiKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApiKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListApiKeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListApiKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApiKeysResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApiKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListApiKeysResponseValidationError is the validation error returned by
+// ListApiKeysResponse.Validate if the designated constraints aren't met.
+type ListApiKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApiKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApiKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApiKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApiKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApiKeysResponseValidationError) ErrorName() string {
+	return "ListApiKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApiKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApiKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApiKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApiKeysResponseValidationError{}
+
+// Validate checks the field values on RevokeApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeApiKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeApiKeyRequestMultiError, or nil if none found.
+func (m *RevokeApiKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeApiKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RevokeApiKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeApiKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeApiKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeApiKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeApiKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeApiKeyRequestMultiError) AllErrors() []error { return m }
+
+// RevokeApiKeyRequestValidationError is the validation error returned by
+// RevokeApiKeyRequest.Validate if the designated constraints aren't met.
+type RevokeApiKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeApiKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeApiKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeApiKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeApiKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeApiKeyRequestValidationError) ErrorName() string {
+	return "RevokeApiKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeApiKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeApiKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeApiKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeApiKeyRequestValidationError{}
+
+// Validate checks the field values on RevokeApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeApiKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeApiKeyResponseMultiError, or nil if none found.
+func (m *RevokeApiKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeApiKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revoked
+
+	if len(errors) > 0 {
+		return RevokeApiKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeApiKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeApiKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeApiKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeApiKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeApiKeyResponseMultiError) AllErrors() []error { return m }
+
+// RevokeApiKeyResponseValidationError is the validation error returned by
+// RevokeApiKeyResponse.Validate if the designated constraints aren't met.
+type RevokeApiKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeApiKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeApiKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeApiKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeApiKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeApiKeyResponseValidationError) ErrorName() string {
+	return "RevokeApiKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeApiKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeApiKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeApiKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeApiKeyResponseValidationError{}
+
+// Validate checks the field values on CreateDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDownloadTaskRequestMultiError, or nil if none found.
+func (m *CreateDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = CreateDownloadTaskRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := CreateDownloadTaskRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxFileSizeInBytes() > 9223372036854775807 {
+		err := CreateDownloadTaskRequestValidationError{
+			field:  "MaxFileSizeInBytes",
+			reason: "value must be less than or equal to 9223372036854775807",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpectedChecksum()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "ExpectedChecksum",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "ExpectedChecksum",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpectedChecksum()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskRequestValidationError{
+				field:  "ExpectedChecksum",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// CreateDownloadTaskRequestValidationError is the validation error returned by
+// CreateDownloadTaskRequest.Validate if the designated constraints aren't met.
+type CreateDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDownloadTaskRequestValidationError) ErrorName() string {
+	return "CreateDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on CreateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDownloadTaskResponseMultiError, or nil if none found.
+func (m *CreateDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// CreateDownloadTaskResponseValidationError is the validation error returned
+// by CreateDownloadTaskResponse.Validate if the designated constraints aren't met.
+type CreateDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDownloadTaskResponseValidationError) ErrorName() string {
+	return "CreateDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskListRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	if m.GetLimit() > 100 {
+		err := GetDownloadTaskListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDownloadTaskListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskListRequestValidationError is the validation error returned
+// by GetDownloadTaskListRequest.Validate if the designated constraints aren't met.
+type GetDownloadTaskListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskListRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskListRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskListResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDownloadTaskListResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalDownloadTaskCount
+
+	if len(errors) > 0 {
+		return GetDownloadTaskListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskListResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskListResponseValidationError is the validation error returned
+// by GetDownloadTaskListResponse.Validate if the designated constraints
+// aren't met.
+type GetDownloadTaskListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskListResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskListResponseValidationError{}
+
+// Validate checks the field values on UpdateDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDownloadTaskRequestMultiError, or nil if none found.
+func (m *UpdateDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetUrl() != "" {
+
+		if uri, err := url.Parse(m.GetUrl()); err != nil {
+			err = UpdateDownloadTaskRequestValidationError{
+				field:  "Url",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := UpdateDownloadTaskRequestValidationError{
+				field:  "Url",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for DownloadTaskStatus
+
+	if len(errors) > 0 {
+		return UpdateDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDownloadTaskRequestValidationError is the validation error returned by
+// UpdateDownloadTaskRequest.Validate if the designated constraints aren't met.
+type UpdateDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDownloadTaskRequestValidationError) ErrorName() string {
+	return "UpdateDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on UpdateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDownloadTaskResponseMultiError, or nil if none found.
+func (m *UpdateDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Updated
+
+	if len(errors) > 0 {
+		return UpdateDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDownloadTaskResponseValidationError is the validation error returned
+// by UpdateDownloadTaskResponse.Validate if the designated constraints aren't met.
+type UpdateDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDownloadTaskResponseValidationError) ErrorName() string {
+	return "UpdateDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on DeleteDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDownloadTaskRequestMultiError, or nil if none found.
+func (m *DeleteDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDownloadTaskRequestValidationError is the validation error returned by
+// DeleteDownloadTaskRequest.Validate if the designated constraints aren't met.
+type DeleteDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDownloadTaskRequestValidationError) ErrorName() string {
+	return "DeleteDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on DeleteDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDownloadTaskResponseMultiError, or nil if none found.
+func (m *DeleteDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return DeleteDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// DeleteDownloadTaskResponseValidationError is the validation error returned
+// by DeleteDownloadTaskResponse.Validate if the designated constraints aren't met.
+type DeleteDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDownloadTaskResponseValidationError) ErrorName() string {
+	return "DeleteDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on CancelDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDownloadTaskRequestMultiError, or nil if none found.
+func (m *CancelDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// CancelDownloadTaskRequestValidationError is the validation error returned by
+// CancelDownloadTaskRequest.Validate if the designated constraints aren't met.
+type CancelDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDownloadTaskRequestValidationError) ErrorName() string {
+	return "CancelDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on CancelDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDownloadTaskResponseMultiError, or nil if none found.
+func (m *CancelDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Canceled
+
+	if len(errors) > 0 {
+		return CancelDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// CancelDownloadTaskResponseValidationError is the validation error returned
+// by CancelDownloadTaskResponse.Validate if the designated constraints aren't met.
+type CancelDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDownloadTaskResponseValidationError) ErrorName() string {
+	return "CancelDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on PauseDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseDownloadTaskRequestMultiError, or nil if none found.
+func (m *PauseDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return PauseDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by PauseDownloadTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PauseDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// PauseDownloadTaskRequestValidationError is the validation error returned by
+// PauseDownloadTaskRequest.Validate if the designated constraints aren't met.
+type PauseDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseDownloadTaskRequestValidationError) ErrorName() string {
+	return "PauseDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on PauseDownloadTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseDownloadTaskResponseMultiError, or nil if none found.
+func (m *PauseDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Paused
+
+	if len(errors) > 0 {
+		return PauseDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseDownloadTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by PauseDownloadTaskResponse.ValidateAll() if the
+// designated constraints aren't met.
+type PauseDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// PauseDownloadTaskResponseValidationError is the validation error returned by
+// PauseDownloadTaskResponse.Validate if the designated constraints aren't met.
+type PauseDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseDownloadTaskResponseValidationError) ErrorName() string {
+	return "PauseDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on ResumeDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeDownloadTaskRequestMultiError, or nil if none found.
+func (m *ResumeDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ResumeDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ResumeDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// ResumeDownloadTaskRequestValidationError is the validation error returned by
+// ResumeDownloadTaskRequest.Validate if the designated constraints aren't met.
+type ResumeDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeDownloadTaskRequestValidationError) ErrorName() string {
+	return "ResumeDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on ResumeDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeDownloadTaskResponseMultiError, or nil if none found.
+func (m *ResumeDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Resumed
+
+	if len(errors) > 0 {
+		return ResumeDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by ResumeDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ResumeDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// ResumeDownloadTaskResponseValidationError is the validation error returned
+// by ResumeDownloadTaskResponse.Validate if the designated constraints aren't met.
+type ResumeDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeDownloadTaskResponseValidationError) ErrorName() string {
+	return "ResumeDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskFileRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return GetDownloadTaskFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskFileRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskFileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskFileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskFileRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskFileRequestValidationError is the validation error returned
+// by GetDownloadTaskFileRequest.Validate if the designated constraints aren't met.
+type GetDownloadTaskFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskFileRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskFileRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskFileResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return GetDownloadTaskFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskFileResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskFileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskFileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskFileResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskFileResponseValidationError is the validation error returned
+// by GetDownloadTaskFileResponse.Validate if the designated constraints
+// aren't met.
+type GetDownloadTaskFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskFileResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskFileResponseValidationError{}
+
+// Validate checks the field values on WatchDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchDownloadTaskRequestMultiError, or nil if none found.
+func (m *WatchDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return WatchDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchDownloadTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// WatchDownloadTaskRequestValidationError is the validation error returned by
+// WatchDownloadTaskRequest.Validate if the designated constraints aren't met.
+type WatchDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchDownloadTaskRequestValidationError) ErrorName() string {
+	return "WatchDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on WatchDownloadTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchDownloadTaskResponseMultiError, or nil if none found.
+func (m *WatchDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchDownloadTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchDownloadTaskResponse.ValidateAll() if the
+// designated constraints aren't met.
+type WatchDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// WatchDownloadTaskResponseValidationError is the validation error returned by
+// WatchDownloadTaskResponse.Validate if the designated constraints aren't met.
+type WatchDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchDownloadTaskResponseValidationError) ErrorName() string {
+	return "WatchDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on AdminGetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminGetDownloadTaskListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminGetDownloadTaskListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminGetDownloadTaskListRequestMultiError, or nil if none found.
+func (m *AdminGetDownloadTaskListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminGetDownloadTaskListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	if m.GetLimit() > 100 {
+		err := AdminGetDownloadTaskListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OfAccountId
+
+	if len(errors) > 0 {
+		return AdminGetDownloadTaskListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminGetDownloadTaskListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminGetDownloadTaskListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminGetDownloadTaskListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminGetDownloadTaskListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminGetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+
+// AdminGetDownloadTaskListRequestValidationError is the validation error
+// returned by AdminGetDownloadTaskListRequest.Validate if the designated
+// constraints aren't met.
+type AdminGetDownloadTaskListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminGetDownloadTaskListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminGetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminGetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminGetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminGetDownloadTaskListRequestValidationError) ErrorName() string {
+	return "AdminGetDownloadTaskListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminGetDownloadTaskListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminGetDownloadTaskListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminGetDownloadTaskListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminGetDownloadTaskListRequestValidationError{}
+
+// Validate checks the field values on AdminGetDownloadTaskListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminGetDownloadTaskListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminGetDownloadTaskListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminGetDownloadTaskListResponseMultiError, or nil if none found.
+func (m *AdminGetDownloadTaskListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminGetDownloadTaskListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminGetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminGetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminGetDownloadTaskListResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalDownloadTaskCount
+
+	if len(errors) > 0 {
+		return AdminGetDownloadTaskListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminGetDownloadTaskListResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminGetDownloadTaskListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminGetDownloadTaskListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminGetDownloadTaskListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminGetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+
+// AdminGetDownloadTaskListResponseValidationError is the validation error
+// returned by AdminGetDownloadTaskListResponse.Validate if the designated
+// constraints aren't met.
+type AdminGetDownloadTaskListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminGetDownloadTaskListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminGetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminGetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminGetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminGetDownloadTaskListResponseValidationError) ErrorName() string {
+	return "AdminGetDownloadTaskListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminGetDownloadTaskListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminGetDownloadTaskListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminGetDownloadTaskListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminGetDownloadTaskListResponseValidationError{}
+
+// Validate checks the field values on AdminFailDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminFailDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFailDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminFailDownloadTaskRequestMultiError, or nil if none found.
+func (m *AdminFailDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFailDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetReason()) > 256 {
+		err := AdminFailDownloadTaskRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminFailDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFailDownloadTaskRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminFailDownloadTaskRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminFailDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFailDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFailDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// AdminFailDownloadTaskRequestValidationError is the validation error returned
+// by AdminFailDownloadTaskRequest.Validate if the designated constraints
+// aren't met.
+type AdminFailDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFailDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFailDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFailDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFailDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFailDownloadTaskRequestValidationError) ErrorName() string {
+	return "AdminFailDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminFailDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFailDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFailDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFailDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on AdminFailDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminFailDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFailDownloadTaskResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminFailDownloadTaskResponseMultiError, or nil if none found.
+func (m *AdminFailDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFailDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return AdminFailDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFailDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminFailDownloadTaskResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AdminFailDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFailDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFailDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// AdminFailDownloadTaskResponseValidationError is the validation error
+// returned by AdminFailDownloadTaskResponse.Validate if the designated
+// constraints aren't met.
+type AdminFailDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFailDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFailDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFailDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFailDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFailDownloadTaskResponseValidationError) ErrorName() string {
+	return "AdminFailDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminFailDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFailDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFailDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFailDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on AdminRetryDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRetryDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRetryDownloadTaskRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminRetryDownloadTaskRequestMultiError, or nil if none found.
+func (m *AdminRetryDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRetryDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AdminRetryDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRetryDownloadTaskRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminRetryDownloadTaskRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminRetryDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRetryDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRetryDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// AdminRetryDownloadTaskRequestValidationError is the validation error
+// returned by AdminRetryDownloadTaskRequest.Validate if the designated
+// constraints aren't met.
+type AdminRetryDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRetryDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRetryDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRetryDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRetryDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRetryDownloadTaskRequestValidationError) ErrorName() string {
+	return "AdminRetryDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRetryDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRetryDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRetryDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRetryDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on AdminRetryDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRetryDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRetryDownloadTaskResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminRetryDownloadTaskResponseMultiError, or nil if none found.
+func (m *AdminRetryDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRetryDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Retried
+
+	if len(errors) > 0 {
+		return AdminRetryDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRetryDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminRetryDownloadTaskResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AdminRetryDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRetryDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRetryDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// AdminRetryDownloadTaskResponseValidationError is the validation error
+// returned by AdminRetryDownloadTaskResponse.Validate if the designated
+// constraints aren't met.
+type AdminRetryDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRetryDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRetryDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRetryDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRetryDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRetryDownloadTaskResponseValidationError) ErrorName() string {
+	return "AdminRetryDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRetryDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRetryDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRetryDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRetryDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on AdminDisableAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDisableAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDisableAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDisableAccountRequestMultiError, or nil if none found.
+func (m *AdminDisableAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDisableAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
-	// no validation rules for DownloadStatus
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return DownloadTaskMultiError(errors)
+		return AdminDisableAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DownloadTaskMultiError is an error wrapping multiple validation errors
-// returned by DownloadTask.ValidateAll() if the designated constraints aren't met.
-type DownloadTaskMultiError []error
+// AdminDisableAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDisableAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDisableAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DownloadTaskMultiError) Error() string {
+func (m AdminDisableAccountRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -217,11 +6315,11 @@ func (m DownloadTaskMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DownloadTaskMultiError) AllErrors() []error { return m }
+func (m AdminDisableAccountRequestMultiError) AllErrors() []error { return m }
 
-// DownloadTaskValidationError is the validation error returned by
-// DownloadTask.Validate if the designated constraints aren't met.
-type DownloadTaskValidationError struct {
+// AdminDisableAccountRequestValidationError is the validation error returned
+// by AdminDisableAccountRequest.Validate if the designated constraints aren't met.
+type AdminDisableAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -229,22 +6327,24 @@ type DownloadTaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e DownloadTaskValidationError) Field() string { return e.field }
+func (e AdminDisableAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DownloadTaskValidationError) Reason() string { return e.reason }
+func (e AdminDisableAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DownloadTaskValidationError) Cause() error { return e.cause }
+func (e AdminDisableAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DownloadTaskValidationError) Key() bool { return e.key }
+func (e AdminDisableAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DownloadTaskValidationError) ErrorName() string { return "DownloadTaskValidationError" }
+func (e AdminDisableAccountRequestValidationError) ErrorName() string {
+	return "AdminDisableAccountRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DownloadTaskValidationError) Error() string {
+func (e AdminDisableAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -256,14 +6356,14 @@ func (e DownloadTaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDownloadTask.%s: %s%s",
+		"invalid %sAdminDisableAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DownloadTaskValidationError{}
+var _ error = AdminDisableAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -271,66 +6371,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DownloadTaskValidationError{}
+} = AdminDisableAccountRequestValidationError{}
 
-// Validate checks the field values on CreateAccountRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminDisableAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAccountRequest) Validate() error {
+func (m *AdminDisableAccountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAccountRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDisableAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAccountRequestMultiError, or nil if none found.
-func (m *CreateAccountRequest) ValidateAll() error {
+// AdminDisableAccountResponseMultiError, or nil if none found.
+func (m *AdminDisableAccountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAccountRequest) validate(all bool) error {
+func (m *AdminDisableAccountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CreateAccountRequest_AccountName_Pattern.MatchString(m.GetAccountName()) {
-		err := CreateAccountRequestValidationError{
-			field:  "AccountName",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_CreateAccountRequest_Password_Pattern.MatchString(m.GetPassword()) {
-		err := CreateAccountRequestValidationError{
-			field:  "Password",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Disabled
 
 	if len(errors) > 0 {
-		return CreateAccountRequestMultiError(errors)
+		return AdminDisableAccountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAccountRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateAccountRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateAccountRequestMultiError []error
+// AdminDisableAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminDisableAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDisableAccountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAccountRequestMultiError) Error() string {
+func (m AdminDisableAccountResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -339,11 +6419,12 @@ func (m CreateAccountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAccountRequestMultiError) AllErrors() []error { return m }
+func (m AdminDisableAccountResponseMultiError) AllErrors() []error { return m }
 
-// CreateAccountRequestValidationError is the validation error returned by
-// CreateAccountRequest.Validate if the designated constraints aren't met.
-type CreateAccountRequestValidationError struct {
+// AdminDisableAccountResponseValidationError is the validation error returned
+// by AdminDisableAccountResponse.Validate if the designated constraints
+// aren't met.
+type AdminDisableAccountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -351,24 +6432,24 @@ type CreateAccountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAccountRequestValidationError) Field() string { return e.field }
+func (e AdminDisableAccountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAccountRequestValidationError) Reason() string { return e.reason }
+func (e AdminDisableAccountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAccountRequestValidationError) Cause() error { return e.cause }
+func (e AdminDisableAccountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAccountRequestValidationError) Key() bool { return e.key }
+func (e AdminDisableAccountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAccountRequestValidationError) ErrorName() string {
-	return "CreateAccountRequestValidationError"
+func (e AdminDisableAccountResponseValidationError) ErrorName() string {
+	return "AdminDisableAccountResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAccountRequestValidationError) Error() string {
+func (e AdminDisableAccountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -380,14 +6461,14 @@ func (e CreateAccountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAccountRequest.%s: %s%s",
+		"invalid %sAdminDisableAccountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAccountRequestValidationError{}
+var _ error = AdminDisableAccountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -395,50 +6476,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAccountRequestValidationError{}
+} = AdminDisableAccountResponseValidationError{}
 
-var _CreateAccountRequest_AccountName_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
-
-var _CreateAccountRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
-
-// Validate checks the field values on CreateAccountResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUpdateAccountRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAccountResponse) Validate() error {
+func (m *AdminUpdateAccountRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAccountResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateAccountResponseMultiError, or nil if none found.
-func (m *CreateAccountResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminUpdateAccountRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUpdateAccountRoleRequestMultiError, or nil if none found.
+func (m *AdminUpdateAccountRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAccountResponse) validate(all bool) error {
+func (m *AdminUpdateAccountRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
+	// no validation rules for Id
+
+	if _, ok := Role_name[int32(m.GetRole())]; !ok {
+		err := AdminUpdateAccountRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CreateAccountResponseMultiError(errors)
+		return AdminUpdateAccountRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAccountResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateAccountResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateAccountResponseMultiError []error
+// AdminUpdateAccountRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateAccountRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUpdateAccountRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAccountResponseMultiError) Error() string {
+func (m AdminUpdateAccountRoleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -447,11 +6535,12 @@ func (m CreateAccountResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAccountResponseMultiError) AllErrors() []error { return m }
+func (m AdminUpdateAccountRoleRequestMultiError) AllErrors() []error { return m }
 
-// CreateAccountResponseValidationError is the validation error returned by
-// CreateAccountResponse.Validate if the designated constraints aren't met.
-type CreateAccountResponseValidationError struct {
+// AdminUpdateAccountRoleRequestValidationError is the validation error
+// returned by AdminUpdateAccountRoleRequest.Validate if the designated
+// constraints aren't met.
+type AdminUpdateAccountRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -459,24 +6548,24 @@ type CreateAccountResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAccountResponseValidationError) Field() string { return e.field }
+func (e AdminUpdateAccountRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAccountResponseValidationError) Reason() string { return e.reason }
+func (e AdminUpdateAccountRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAccountResponseValidationError) Cause() error { return e.cause }
+func (e AdminUpdateAccountRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAccountResponseValidationError) Key() bool { return e.key }
+func (e AdminUpdateAccountRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAccountResponseValidationError) ErrorName() string {
-	return "CreateAccountResponseValidationError"
+func (e AdminUpdateAccountRoleRequestValidationError) ErrorName() string {
+	return "AdminUpdateAccountRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAccountResponseValidationError) Error() string {
+func (e AdminUpdateAccountRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -488,14 +6577,14 @@ func (e CreateAccountResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAccountResponse.%s: %s%s",
+		"invalid %sAdminUpdateAccountRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAccountResponseValidationError{}
+var _ error = AdminUpdateAccountRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -503,66 +6592,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAccountResponseValidationError{}
+} = AdminUpdateAccountRoleRequestValidationError{}
 
-// Validate checks the field values on CreateSessionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUpdateAccountRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSessionRequest) Validate() error {
+func (m *AdminUpdateAccountRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSessionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateSessionRequestMultiError, or nil if none found.
-func (m *CreateSessionRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminUpdateAccountRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUpdateAccountRoleResponseMultiError, or nil if none found.
+func (m *AdminUpdateAccountRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSessionRequest) validate(all bool) error {
+func (m *AdminUpdateAccountRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_CreateSessionRequest_AccountName_Pattern.MatchString(m.GetAccountName()) {
-		err := CreateSessionRequestValidationError{
-			field:  "AccountName",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_CreateSessionRequest_Password_Pattern.MatchString(m.GetPassword()) {
-		err := CreateSessionRequestValidationError{
-			field:  "Password",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Updated
 
 	if len(errors) > 0 {
-		return CreateSessionRequestMultiError(errors)
+		return AdminUpdateAccountRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSessionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSessionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateSessionRequestMultiError []error
+// AdminUpdateAccountRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateAccountRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUpdateAccountRoleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSessionRequestMultiError) Error() string {
+func (m AdminUpdateAccountRoleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -571,11 +6640,12 @@ func (m CreateSessionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSessionRequestMultiError) AllErrors() []error { return m }
+func (m AdminUpdateAccountRoleResponseMultiError) AllErrors() []error { return m }
 
-// CreateSessionRequestValidationError is the validation error returned by
-// CreateSessionRequest.Validate if the designated constraints aren't met.
-type CreateSessionRequestValidationError struct {
+// AdminUpdateAccountRoleResponseValidationError is the validation error
+// returned by AdminUpdateAccountRoleResponse.Validate if the designated
+// constraints aren't met.
+type AdminUpdateAccountRoleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -583,24 +6653,24 @@ type CreateSessionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSessionRequestValidationError) Field() string { return e.field }
+func (e AdminUpdateAccountRoleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSessionRequestValidationError) Reason() string { return e.reason }
+func (e AdminUpdateAccountRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSessionRequestValidationError) Cause() error { return e.cause }
+func (e AdminUpdateAccountRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSessionRequestValidationError) Key() bool { return e.key }
+func (e AdminUpdateAccountRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSessionRequestValidationError) ErrorName() string {
-	return "CreateSessionRequestValidationError"
+func (e AdminUpdateAccountRoleResponseValidationError) ErrorName() string {
+	return "AdminUpdateAccountRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSessionRequestValidationError) Error() string {
+func (e AdminUpdateAccountRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -612,14 +6682,14 @@ func (e CreateSessionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSessionRequest.%s: %s%s",
+		"invalid %sAdminUpdateAccountRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSessionRequestValidationError{}
+var _ error = AdminUpdateAccountRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -627,79 +6697,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSessionRequestValidationError{}
-
-var _CreateSessionRequest_AccountName_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
-
-var _CreateSessionRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+} = AdminUpdateAccountRoleResponseValidationError{}
 
-// Validate checks the field values on CreateSessionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSessionResponse) Validate() error {
+// Validate checks the field values on AdminUpdateAccountMaxFileSizeRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminUpdateAccountMaxFileSizeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSessionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateSessionResponseMultiError, or nil if none found.
-func (m *CreateSessionResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminUpdateAccountMaxFileSizeRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminUpdateAccountMaxFileSizeRequestMultiError, or nil if none found.
+func (m *AdminUpdateAccountMaxFileSizeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSessionResponse) validate(all bool) error {
+func (m *AdminUpdateAccountMaxFileSizeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateSessionResponseValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateSessionResponseValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for Id
+
+	if m.GetMaxFileSizeInBytes() > 9223372036854775807 {
+		err := AdminUpdateAccountMaxFileSizeRequestValidationError{
+			field:  "MaxFileSizeInBytes",
+			reason: "value must be less than or equal to 9223372036854775807",
 		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateSessionResponseValidationError{
-				field:  "Account",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Token
-
 	if len(errors) > 0 {
-		return CreateSessionResponseMultiError(errors)
+		return AdminUpdateAccountMaxFileSizeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSessionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateSessionResponse.ValidateAll() if the designated
+// AdminUpdateAccountMaxFileSizeRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminUpdateAccountMaxFileSizeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSessionResponseMultiError []error
+type AdminUpdateAccountMaxFileSizeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSessionResponseMultiError) Error() string {
+func (m AdminUpdateAccountMaxFileSizeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -708,11 +6758,12 @@ func (m CreateSessionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSessionResponseMultiError) AllErrors() []error { return m }
+func (m AdminUpdateAccountMaxFileSizeRequestMultiError) AllErrors() []error { return m }
 
-// CreateSessionResponseValidationError is the validation error returned by
-// CreateSessionResponse.Validate if the designated constraints aren't met.
-type CreateSessionResponseValidationError struct {
+// AdminUpdateAccountMaxFileSizeRequestValidationError is the validation error
+// returned by AdminUpdateAccountMaxFileSizeRequest.Validate if the designated
+// constraints aren't met.
+type AdminUpdateAccountMaxFileSizeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -720,24 +6771,24 @@ type CreateSessionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSessionResponseValidationError) Field() string { return e.field }
+func (e AdminUpdateAccountMaxFileSizeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSessionResponseValidationError) Reason() string { return e.reason }
+func (e AdminUpdateAccountMaxFileSizeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSessionResponseValidationError) Cause() error { return e.cause }
+func (e AdminUpdateAccountMaxFileSizeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSessionResponseValidationError) Key() bool { return e.key }
+func (e AdminUpdateAccountMaxFileSizeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSessionResponseValidationError) ErrorName() string {
-	return "CreateSessionResponseValidationError"
+func (e AdminUpdateAccountMaxFileSizeRequestValidationError) ErrorName() string {
+	return "AdminUpdateAccountMaxFileSizeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSessionResponseValidationError) Error() string {
+func (e AdminUpdateAccountMaxFileSizeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -749,14 +6800,14 @@ func (e CreateSessionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSessionResponse.%s: %s%s",
+		"invalid %sAdminUpdateAccountMaxFileSizeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSessionResponseValidationError{}
+var _ error = AdminUpdateAccountMaxFileSizeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -764,65 +6815,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSessionResponseValidationError{}
+} = AdminUpdateAccountMaxFileSizeRequestValidationError{}
 
-// Validate checks the field values on CreateDownloadTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDownloadTaskRequest) Validate() error {
+// Validate checks the field values on AdminUpdateAccountMaxFileSizeResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminUpdateAccountMaxFileSizeResponse) Validate() error {
 	return m.validate(false)
-}
-
-// ValidateAll checks the field values on CreateDownloadTaskRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateDownloadTaskRequestMultiError, or nil if none found.
-func (m *CreateDownloadTaskRequest) ValidateAll() error {
+}
+
+// ValidateAll checks the field values on AdminUpdateAccountMaxFileSizeResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminUpdateAccountMaxFileSizeResponseMultiError, or nil if none found.
+func (m *AdminUpdateAccountMaxFileSizeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDownloadTaskRequest) validate(all bool) error {
+func (m *AdminUpdateAccountMaxFileSizeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if uri, err := url.Parse(m.GetUrl()); err != nil {
-		err = CreateDownloadTaskRequestValidationError{
-			field:  "Url",
-			reason: "value must be a valid URI",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	} else if !uri.IsAbs() {
-		err := CreateDownloadTaskRequestValidationError{
-			field:  "Url",
-			reason: "value must be absolute",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Updated
 
 	if len(errors) > 0 {
-		return CreateDownloadTaskRequestMultiError(errors)
+		return AdminUpdateAccountMaxFileSizeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CreateDownloadTaskRequestMultiError []error
+// AdminUpdateAccountMaxFileSizeResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminUpdateAccountMaxFileSizeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUpdateAccountMaxFileSizeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDownloadTaskRequestMultiError) Error() string {
+func (m AdminUpdateAccountMaxFileSizeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -831,11 +6865,12 @@ func (m CreateDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m AdminUpdateAccountMaxFileSizeResponseMultiError) AllErrors() []error { return m }
 
-// CreateDownloadTaskRequestValidationError is the validation error returned by
-// CreateDownloadTaskRequest.Validate if the designated constraints aren't met.
-type CreateDownloadTaskRequestValidationError struct {
+// AdminUpdateAccountMaxFileSizeResponseValidationError is the validation error
+// returned by AdminUpdateAccountMaxFileSizeResponse.Validate if the
+// designated constraints aren't met.
+type AdminUpdateAccountMaxFileSizeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -843,24 +6878,24 @@ type CreateDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e AdminUpdateAccountMaxFileSizeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e AdminUpdateAccountMaxFileSizeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e AdminUpdateAccountMaxFileSizeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e AdminUpdateAccountMaxFileSizeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDownloadTaskRequestValidationError) ErrorName() string {
-	return "CreateDownloadTaskRequestValidationError"
+func (e AdminUpdateAccountMaxFileSizeResponseValidationError) ErrorName() string {
+	return "AdminUpdateAccountMaxFileSizeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDownloadTaskRequestValidationError) Error() string {
+func (e AdminUpdateAccountMaxFileSizeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -872,14 +6907,14 @@ func (e CreateDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDownloadTaskRequest.%s: %s%s",
+		"invalid %sAdminUpdateAccountMaxFileSizeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDownloadTaskRequestValidationError{}
+var _ error = AdminUpdateAccountMaxFileSizeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -887,53 +6922,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDownloadTaskRequestValidationError{}
+} = AdminUpdateAccountMaxFileSizeResponseValidationError{}
 
-// Validate checks the field values on CreateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDownloadTaskResponse) Validate() error {
+// Validate checks the field values on UrlPolicy with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UrlPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateDownloadTaskResponseMultiError, or nil if none found.
-func (m *CreateDownloadTaskResponse) ValidateAll() error {
+// ValidateAll checks the field values on UrlPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UrlPolicyMultiError, or nil
+// if none found.
+func (m *UrlPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDownloadTaskResponse) validate(all bool) error {
+func (m *UrlPolicy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for OfAccountId
+
+	// no validation rules for Action
+
+	// no validation rules for HostGlob
+
+	// no validation rules for UrlRegex
+
+	// no validation rules for Scheme
+
+	// no validation rules for Port
+
+	// no validation rules for Description
+
 	if all {
-		switch v := interface{}(m.GetDownloadTask()).(type) {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
+				errors = append(errors, UrlPolicyValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
+				errors = append(errors, UrlPolicyValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateDownloadTaskResponseValidationError{
-				field:  "DownloadTask",
+			return UrlPolicyValidationError{
+				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -941,19 +6992,18 @@ func (m *CreateDownloadTaskResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateDownloadTaskResponseMultiError(errors)
+		return UrlPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateDownloadTaskResponseMultiError []error
+// UrlPolicyMultiError is an error wrapping multiple validation errors returned
+// by UrlPolicy.ValidateAll() if the designated constraints aren't met.
+type UrlPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDownloadTaskResponseMultiError) Error() string {
+func (m UrlPolicyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -962,11 +7012,11 @@ func (m CreateDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m UrlPolicyMultiError) AllErrors() []error { return m }
 
-// CreateDownloadTaskResponseValidationError is the validation error returned
-// by CreateDownloadTaskResponse.Validate if the designated constraints aren't met.
-type CreateDownloadTaskResponseValidationError struct {
+// UrlPolicyValidationError is the validation error returned by
+// UrlPolicy.Validate if the designated constraints aren't met.
+type UrlPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -974,24 +7024,22 @@ type CreateDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e UrlPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e UrlPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e UrlPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e UrlPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDownloadTaskResponseValidationError) ErrorName() string {
-	return "CreateDownloadTaskResponseValidationError"
-}
+func (e UrlPolicyValidationError) ErrorName() string { return "UrlPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateDownloadTaskResponseValidationError) Error() string {
+func (e UrlPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1003,14 +7051,14 @@ func (e CreateDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDownloadTaskResponse.%s: %s%s",
+		"invalid %sUrlPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDownloadTaskResponseValidationError{}
+var _ error = UrlPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1018,36 +7066,91 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDownloadTaskResponseValidationError{}
+} = UrlPolicyValidationError{}
 
-// Validate checks the field values on GetDownloadTaskListRequest with the
+// Validate checks the field values on AdminCreateUrlPolicyRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskListRequest) Validate() error {
+func (m *AdminCreateUrlPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskListRequest with the
+// ValidateAll checks the field values on AdminCreateUrlPolicyRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskListRequestMultiError, or nil if none found.
-func (m *GetDownloadTaskListRequest) ValidateAll() error {
+// AdminCreateUrlPolicyRequestMultiError, or nil if none found.
+func (m *AdminCreateUrlPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskListRequest) validate(all bool) error {
+func (m *AdminCreateUrlPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Offset
+	// no validation rules for OfAccountId
 
-	if m.GetLimit() > 100 {
-		err := GetDownloadTaskListRequestValidationError{
-			field:  "Limit",
-			reason: "value must be less than or equal to 100",
+	if _, ok := UrlPolicyAction_name[int32(m.GetAction())]; !ok {
+		err := AdminCreateUrlPolicyRequestValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetHostGlob()) > 256 {
+		err := AdminCreateUrlPolicyRequestValidationError{
+			field:  "HostGlob",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrlRegex()) > 512 {
+		err := AdminCreateUrlPolicyRequestValidationError{
+			field:  "UrlRegex",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdminCreateUrlPolicyRequest_Scheme_InLookup[m.GetScheme()]; !ok {
+		err := AdminCreateUrlPolicyRequestValidationError{
+			field:  "Scheme",
+			reason: "value must be in list [ http https]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPort() > 65535 {
+		err := AdminCreateUrlPolicyRequestValidationError{
+			field:  "Port",
+			reason: "value must be less than or equal to 65535",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 256 {
+		err := AdminCreateUrlPolicyRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 256 runes",
 		}
 		if !all {
 			return err
@@ -1056,19 +7159,19 @@ func (m *GetDownloadTaskListRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetDownloadTaskListRequestMultiError(errors)
+		return AdminCreateUrlPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskListRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskListRequest.ValidateAll() if
+// AdminCreateUrlPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminCreateUrlPolicyRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetDownloadTaskListRequestMultiError []error
+type AdminCreateUrlPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskListRequestMultiError) Error() string {
+func (m AdminCreateUrlPolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1077,11 +7180,12 @@ func (m GetDownloadTaskListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+func (m AdminCreateUrlPolicyRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskListRequestValidationError is the validation error returned
-// by GetDownloadTaskListRequest.Validate if the designated constraints aren't met.
-type GetDownloadTaskListRequestValidationError struct {
+// AdminCreateUrlPolicyRequestValidationError is the validation error returned
+// by AdminCreateUrlPolicyRequest.Validate if the designated constraints
+// aren't met.
+type AdminCreateUrlPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1089,24 +7193,24 @@ type GetDownloadTaskListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskListRequestValidationError) Field() string { return e.field }
+func (e AdminCreateUrlPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+func (e AdminCreateUrlPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+func (e AdminCreateUrlPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+func (e AdminCreateUrlPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskListRequestValidationError) ErrorName() string {
-	return "GetDownloadTaskListRequestValidationError"
+func (e AdminCreateUrlPolicyRequestValidationError) ErrorName() string {
+	return "AdminCreateUrlPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskListRequestValidationError) Error() string {
+func (e AdminCreateUrlPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1118,14 +7222,14 @@ func (e GetDownloadTaskListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskListRequest.%s: %s%s",
+		"invalid %sAdminCreateUrlPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskListRequestValidationError{}
+var _ error = AdminCreateUrlPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1133,80 +7237,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskListRequestValidationError{}
+} = AdminCreateUrlPolicyRequestValidationError{}
 
-// Validate checks the field values on GetDownloadTaskListResponse with the
+var _AdminCreateUrlPolicyRequest_Scheme_InLookup = map[string]struct{}{
+	"":      {},
+	"http":  {},
+	"https": {},
+}
+
+// Validate checks the field values on AdminCreateUrlPolicyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskListResponse) Validate() error {
+func (m *AdminCreateUrlPolicyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskListResponse with the
+// ValidateAll checks the field values on AdminCreateUrlPolicyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskListResponseMultiError, or nil if none found.
-func (m *GetDownloadTaskListResponse) ValidateAll() error {
+// AdminCreateUrlPolicyResponseMultiError, or nil if none found.
+func (m *AdminCreateUrlPolicyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskListResponse) validate(all bool) error {
+func (m *AdminCreateUrlPolicyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDownloadTaskList() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetDownloadTaskListResponseValidationError{
-						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetDownloadTaskListResponseValidationError{
-						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetUrlPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminCreateUrlPolicyResponseValidationError{
+					field:  "UrlPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetDownloadTaskListResponseValidationError{
-					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+				errors = append(errors, AdminCreateUrlPolicyResponseValidationError{
+					field:  "UrlPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUrlPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminCreateUrlPolicyResponseValidationError{
+				field:  "UrlPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for TotalDownloadTaskCount
-
 	if len(errors) > 0 {
-		return GetDownloadTaskListResponseMultiError(errors)
+		return AdminCreateUrlPolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskListResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskListResponse.ValidateAll() if
+// AdminCreateUrlPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminCreateUrlPolicyResponse.ValidateAll() if
 // the designated constraints aren't met.
-type GetDownloadTaskListResponseMultiError []error
+type AdminCreateUrlPolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskListResponseMultiError) Error() string {
+func (m AdminCreateUrlPolicyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1215,12 +7318,12 @@ func (m GetDownloadTaskListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+func (m AdminCreateUrlPolicyResponseMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskListResponseValidationError is the validation error returned
-// by GetDownloadTaskListResponse.Validate if the designated constraints
+// AdminCreateUrlPolicyResponseValidationError is the validation error returned
+// by AdminCreateUrlPolicyResponse.Validate if the designated constraints
 // aren't met.
-type GetDownloadTaskListResponseValidationError struct {
+type AdminCreateUrlPolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1228,24 +7331,24 @@ type GetDownloadTaskListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskListResponseValidationError) Field() string { return e.field }
+func (e AdminCreateUrlPolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+func (e AdminCreateUrlPolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+func (e AdminCreateUrlPolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+func (e AdminCreateUrlPolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskListResponseValidationError) ErrorName() string {
-	return "GetDownloadTaskListResponseValidationError"
+func (e AdminCreateUrlPolicyResponseValidationError) ErrorName() string {
+	return "AdminCreateUrlPolicyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskListResponseValidationError) Error() string {
+func (e AdminCreateUrlPolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1257,14 +7360,14 @@ func (e GetDownloadTaskListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskListResponse.%s: %s%s",
+		"invalid %sAdminCreateUrlPolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskListResponseValidationError{}
+var _ error = AdminCreateUrlPolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1272,46 +7375,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskListResponseValidationError{}
+} = AdminCreateUrlPolicyResponseValidationError{}
 
-// Validate checks the field values on UpdateDownloadTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminGetUrlPolicyListRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDownloadTaskRequest) Validate() error {
+func (m *AdminGetUrlPolicyListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDownloadTaskRequest with the
+// ValidateAll checks the field values on AdminGetUrlPolicyListRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDownloadTaskRequestMultiError, or nil if none found.
-func (m *UpdateDownloadTaskRequest) ValidateAll() error {
+// AdminGetUrlPolicyListRequestMultiError, or nil if none found.
+func (m *AdminGetUrlPolicyListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDownloadTaskRequest) validate(all bool) error {
+func (m *AdminGetUrlPolicyListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Offset
 
-	if uri, err := url.Parse(m.GetUrl()); err != nil {
-		err = UpdateDownloadTaskRequestValidationError{
-			field:  "Url",
-			reason: "value must be a valid URI",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	} else if !uri.IsAbs() {
-		err := UpdateDownloadTaskRequestValidationError{
-			field:  "Url",
-			reason: "value must be absolute",
+	if m.GetLimit() > 100 {
+		err := AdminGetUrlPolicyListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
 		}
 		if !all {
 			return err
@@ -1319,22 +7412,22 @@ func (m *UpdateDownloadTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for DownloadTaskStatus
+	// no validation rules for OfAccountId
 
 	if len(errors) > 0 {
-		return UpdateDownloadTaskRequestMultiError(errors)
+		return AdminGetUrlPolicyListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateDownloadTaskRequestMultiError []error
+// AdminGetUrlPolicyListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminGetUrlPolicyListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminGetUrlPolicyListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDownloadTaskRequestMultiError) Error() string {
+func (m AdminGetUrlPolicyListRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1343,11 +7436,12 @@ func (m UpdateDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m AdminGetUrlPolicyListRequestMultiError) AllErrors() []error { return m }
 
-// UpdateDownloadTaskRequestValidationError is the validation error returned by
-// UpdateDownloadTaskRequest.Validate if the designated constraints aren't met.
-type UpdateDownloadTaskRequestValidationError struct {
+// AdminGetUrlPolicyListRequestValidationError is the validation error returned
+// by AdminGetUrlPolicyListRequest.Validate if the designated constraints
+// aren't met.
+type AdminGetUrlPolicyListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1355,24 +7449,24 @@ type UpdateDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e AdminGetUrlPolicyListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e AdminGetUrlPolicyListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e AdminGetUrlPolicyListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e AdminGetUrlPolicyListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDownloadTaskRequestValidationError) ErrorName() string {
-	return "UpdateDownloadTaskRequestValidationError"
+func (e AdminGetUrlPolicyListRequestValidationError) ErrorName() string {
+	return "AdminGetUrlPolicyListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDownloadTaskRequestValidationError) Error() string {
+func (e AdminGetUrlPolicyListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1384,14 +7478,14 @@ func (e UpdateDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDownloadTaskRequest.%s: %s%s",
+		"invalid %sAdminGetUrlPolicyListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDownloadTaskRequestValidationError{}
+var _ error = AdminGetUrlPolicyListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1399,46 +7493,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDownloadTaskRequestValidationError{}
+} = AdminGetUrlPolicyListRequestValidationError{}
 
-// Validate checks the field values on UpdateDownloadTaskResponse with the
+// Validate checks the field values on AdminGetUrlPolicyListResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDownloadTaskResponse) Validate() error {
+func (m *AdminGetUrlPolicyListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateDownloadTaskResponseMultiError, or nil if none found.
-func (m *UpdateDownloadTaskResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminGetUrlPolicyListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminGetUrlPolicyListResponseMultiError, or nil if none found.
+func (m *AdminGetUrlPolicyListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDownloadTaskResponse) validate(all bool) error {
+func (m *AdminGetUrlPolicyListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Updated
+	for idx, item := range m.GetUrlPolicyList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminGetUrlPolicyListResponseValidationError{
+						field:  fmt.Sprintf("UrlPolicyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminGetUrlPolicyListResponseValidationError{
+						field:  fmt.Sprintf("UrlPolicyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminGetUrlPolicyListResponseValidationError{
+					field:  fmt.Sprintf("UrlPolicyList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalUrlPolicyCount
 
 	if len(errors) > 0 {
-		return UpdateDownloadTaskResponseMultiError(errors)
+		return AdminGetUrlPolicyListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateDownloadTaskResponseMultiError []error
+// AdminGetUrlPolicyListResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminGetUrlPolicyListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AdminGetUrlPolicyListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDownloadTaskResponseMultiError) Error() string {
+func (m AdminGetUrlPolicyListResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1447,11 +7575,12 @@ func (m UpdateDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m AdminGetUrlPolicyListResponseMultiError) AllErrors() []error { return m }
 
-// UpdateDownloadTaskResponseValidationError is the validation error returned
-// by UpdateDownloadTaskResponse.Validate if the designated constraints aren't met.
-type UpdateDownloadTaskResponseValidationError struct {
+// AdminGetUrlPolicyListResponseValidationError is the validation error
+// returned by AdminGetUrlPolicyListResponse.Validate if the designated
+// constraints aren't met.
+type AdminGetUrlPolicyListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1459,24 +7588,24 @@ type UpdateDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e AdminGetUrlPolicyListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e AdminGetUrlPolicyListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e AdminGetUrlPolicyListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e AdminGetUrlPolicyListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDownloadTaskResponseValidationError) ErrorName() string {
-	return "UpdateDownloadTaskResponseValidationError"
+func (e AdminGetUrlPolicyListResponseValidationError) ErrorName() string {
+	return "AdminGetUrlPolicyListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDownloadTaskResponseValidationError) Error() string {
+func (e AdminGetUrlPolicyListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1488,14 +7617,14 @@ func (e UpdateDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDownloadTaskResponse.%s: %s%s",
+		"invalid %sAdminGetUrlPolicyListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDownloadTaskResponseValidationError{}
+var _ error = AdminGetUrlPolicyListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1503,24 +7632,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDownloadTaskResponseValidationError{}
+} = AdminGetUrlPolicyListResponseValidationError{}
 
-// Validate checks the field values on DeleteDownloadTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUpdateUrlPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDownloadTaskRequest) Validate() error {
+func (m *AdminUpdateUrlPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDownloadTaskRequest with the
+// ValidateAll checks the field values on AdminUpdateUrlPolicyRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteDownloadTaskRequestMultiError, or nil if none found.
-func (m *DeleteDownloadTaskRequest) ValidateAll() error {
+// AdminUpdateUrlPolicyRequestMultiError, or nil if none found.
+func (m *AdminUpdateUrlPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDownloadTaskRequest) validate(all bool) error {
+func (m *AdminUpdateUrlPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1529,20 +7658,86 @@ func (m *DeleteDownloadTaskRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if _, ok := UrlPolicyAction_name[int32(m.GetAction())]; !ok {
+		err := AdminUpdateUrlPolicyRequestValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetHostGlob()) > 256 {
+		err := AdminUpdateUrlPolicyRequestValidationError{
+			field:  "HostGlob",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrlRegex()) > 512 {
+		err := AdminUpdateUrlPolicyRequestValidationError{
+			field:  "UrlRegex",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdminUpdateUrlPolicyRequest_Scheme_InLookup[m.GetScheme()]; !ok {
+		err := AdminUpdateUrlPolicyRequestValidationError{
+			field:  "Scheme",
+			reason: "value must be in list [ http https]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPort() > 65535 {
+		err := AdminUpdateUrlPolicyRequestValidationError{
+			field:  "Port",
+			reason: "value must be less than or equal to 65535",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 256 {
+		err := AdminUpdateUrlPolicyRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return DeleteDownloadTaskRequestMultiError(errors)
+		return AdminUpdateUrlPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteDownloadTaskRequestMultiError []error
+// AdminUpdateUrlPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateUrlPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUpdateUrlPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDownloadTaskRequestMultiError) Error() string {
+func (m AdminUpdateUrlPolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1551,11 +7746,12 @@ func (m DeleteDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m AdminUpdateUrlPolicyRequestMultiError) AllErrors() []error { return m }
 
-// DeleteDownloadTaskRequestValidationError is the validation error returned by
-// DeleteDownloadTaskRequest.Validate if the designated constraints aren't met.
-type DeleteDownloadTaskRequestValidationError struct {
+// AdminUpdateUrlPolicyRequestValidationError is the validation error returned
+// by AdminUpdateUrlPolicyRequest.Validate if the designated constraints
+// aren't met.
+type AdminUpdateUrlPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1563,24 +7759,24 @@ type DeleteDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e AdminUpdateUrlPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e AdminUpdateUrlPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e AdminUpdateUrlPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e AdminUpdateUrlPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDownloadTaskRequestValidationError) ErrorName() string {
-	return "DeleteDownloadTaskRequestValidationError"
+func (e AdminUpdateUrlPolicyRequestValidationError) ErrorName() string {
+	return "AdminUpdateUrlPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDownloadTaskRequestValidationError) Error() string {
+func (e AdminUpdateUrlPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1592,14 +7788,14 @@ func (e DeleteDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDownloadTaskRequest.%s: %s%s",
+		"invalid %sAdminUpdateUrlPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDownloadTaskRequestValidationError{}
+var _ error = AdminUpdateUrlPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1607,46 +7803,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDownloadTaskRequestValidationError{}
+} = AdminUpdateUrlPolicyRequestValidationError{}
 
-// Validate checks the field values on DeleteDownloadTaskResponse with the
+var _AdminUpdateUrlPolicyRequest_Scheme_InLookup = map[string]struct{}{
+	"":      {},
+	"http":  {},
+	"https": {},
+}
+
+// Validate checks the field values on AdminUpdateUrlPolicyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDownloadTaskResponse) Validate() error {
+func (m *AdminUpdateUrlPolicyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDownloadTaskResponse with the
+// ValidateAll checks the field values on AdminUpdateUrlPolicyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteDownloadTaskResponseMultiError, or nil if none found.
-func (m *DeleteDownloadTaskResponse) ValidateAll() error {
+// AdminUpdateUrlPolicyResponseMultiError, or nil if none found.
+func (m *AdminUpdateUrlPolicyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDownloadTaskResponse) validate(all bool) error {
+func (m *AdminUpdateUrlPolicyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Deleted
+	if all {
+		switch v := interface{}(m.GetUrlPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUpdateUrlPolicyResponseValidationError{
+					field:  "UrlPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUpdateUrlPolicyResponseValidationError{
+					field:  "UrlPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUrlPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUpdateUrlPolicyResponseValidationError{
+				field:  "UrlPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteDownloadTaskResponseMultiError(errors)
+		return AdminUpdateUrlPolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteDownloadTaskResponse.ValidateAll() if
+// AdminUpdateUrlPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateUrlPolicyResponse.ValidateAll() if
 // the designated constraints aren't met.
-type DeleteDownloadTaskResponseMultiError []error
+type AdminUpdateUrlPolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDownloadTaskResponseMultiError) Error() string {
+func (m AdminUpdateUrlPolicyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1655,11 +7884,12 @@ func (m DeleteDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m AdminUpdateUrlPolicyResponseMultiError) AllErrors() []error { return m }
 
-// DeleteDownloadTaskResponseValidationError is the validation error returned
-// by DeleteDownloadTaskResponse.Validate if the designated constraints aren't met.
-type DeleteDownloadTaskResponseValidationError struct {
+// AdminUpdateUrlPolicyResponseValidationError is the validation error returned
+// by AdminUpdateUrlPolicyResponse.Validate if the designated constraints
+// aren't met.
+type AdminUpdateUrlPolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1667,24 +7897,24 @@ type DeleteDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e AdminUpdateUrlPolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e AdminUpdateUrlPolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e AdminUpdateUrlPolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e AdminUpdateUrlPolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDownloadTaskResponseValidationError) ErrorName() string {
-	return "DeleteDownloadTaskResponseValidationError"
+func (e AdminUpdateUrlPolicyResponseValidationError) ErrorName() string {
+	return "AdminUpdateUrlPolicyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDownloadTaskResponseValidationError) Error() string {
+func (e AdminUpdateUrlPolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1696,14 +7926,14 @@ func (e DeleteDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDownloadTaskResponse.%s: %s%s",
+		"invalid %sAdminUpdateUrlPolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDownloadTaskResponseValidationError{}
+var _ error = AdminUpdateUrlPolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1711,46 +7941,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDownloadTaskResponseValidationError{}
+} = AdminUpdateUrlPolicyResponseValidationError{}
 
-// Validate checks the field values on GetDownloadTaskFileRequest with the
+// Validate checks the field values on AdminDeleteUrlPolicyRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskFileRequest) Validate() error {
+func (m *AdminDeleteUrlPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskFileRequest with the
+// ValidateAll checks the field values on AdminDeleteUrlPolicyRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskFileRequestMultiError, or nil if none found.
-func (m *GetDownloadTaskFileRequest) ValidateAll() error {
+// AdminDeleteUrlPolicyRequestMultiError, or nil if none found.
+func (m *AdminDeleteUrlPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskFileRequest) validate(all bool) error {
+func (m *AdminDeleteUrlPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetDownloadTaskFileRequestMultiError(errors)
+		return AdminDeleteUrlPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskFileRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskFileRequest.ValidateAll() if
+// AdminDeleteUrlPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDeleteUrlPolicyRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetDownloadTaskFileRequestMultiError []error
+type AdminDeleteUrlPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskFileRequestMultiError) Error() string {
+func (m AdminDeleteUrlPolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1759,11 +7989,12 @@ func (m GetDownloadTaskFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskFileRequestMultiError) AllErrors() []error { return m }
+func (m AdminDeleteUrlPolicyRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskFileRequestValidationError is the validation error returned
-// by GetDownloadTaskFileRequest.Validate if the designated constraints aren't met.
-type GetDownloadTaskFileRequestValidationError struct {
+// AdminDeleteUrlPolicyRequestValidationError is the validation error returned
+// by AdminDeleteUrlPolicyRequest.Validate if the designated constraints
+// aren't met.
+type AdminDeleteUrlPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1771,24 +8002,24 @@ type GetDownloadTaskFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskFileRequestValidationError) Field() string { return e.field }
+func (e AdminDeleteUrlPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskFileRequestValidationError) Reason() string { return e.reason }
+func (e AdminDeleteUrlPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskFileRequestValidationError) Cause() error { return e.cause }
+func (e AdminDeleteUrlPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskFileRequestValidationError) Key() bool { return e.key }
+func (e AdminDeleteUrlPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskFileRequestValidationError) ErrorName() string {
-	return "GetDownloadTaskFileRequestValidationError"
+func (e AdminDeleteUrlPolicyRequestValidationError) ErrorName() string {
+	return "AdminDeleteUrlPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskFileRequestValidationError) Error() string {
+func (e AdminDeleteUrlPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1800,14 +8031,14 @@ func (e GetDownloadTaskFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskFileRequest.%s: %s%s",
+		"invalid %sAdminDeleteUrlPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskFileRequestValidationError{}
+var _ error = AdminDeleteUrlPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1815,46 +8046,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskFileRequestValidationError{}
+} = AdminDeleteUrlPolicyRequestValidationError{}
 
-// Validate checks the field values on GetDownloadTaskFileResponse with the
+// Validate checks the field values on AdminDeleteUrlPolicyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskFileResponse) Validate() error {
+func (m *AdminDeleteUrlPolicyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskFileResponse with the
+// ValidateAll checks the field values on AdminDeleteUrlPolicyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskFileResponseMultiError, or nil if none found.
-func (m *GetDownloadTaskFileResponse) ValidateAll() error {
+// AdminDeleteUrlPolicyResponseMultiError, or nil if none found.
+func (m *AdminDeleteUrlPolicyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskFileResponse) validate(all bool) error {
+func (m *AdminDeleteUrlPolicyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for Deleted
 
 	if len(errors) > 0 {
-		return GetDownloadTaskFileResponseMultiError(errors)
+		return AdminDeleteUrlPolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskFileResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskFileResponse.ValidateAll() if
+// AdminDeleteUrlPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminDeleteUrlPolicyResponse.ValidateAll() if
 // the designated constraints aren't met.
-type GetDownloadTaskFileResponseMultiError []error
+type AdminDeleteUrlPolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskFileResponseMultiError) Error() string {
+func (m AdminDeleteUrlPolicyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1863,12 +8094,12 @@ func (m GetDownloadTaskFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskFileResponseMultiError) AllErrors() []error { return m }
+func (m AdminDeleteUrlPolicyResponseMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskFileResponseValidationError is the validation error returned
-// by GetDownloadTaskFileResponse.Validate if the designated constraints
+// AdminDeleteUrlPolicyResponseValidationError is the validation error returned
+// by AdminDeleteUrlPolicyResponse.Validate if the designated constraints
 // aren't met.
-type GetDownloadTaskFileResponseValidationError struct {
+type AdminDeleteUrlPolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1876,24 +8107,24 @@ type GetDownloadTaskFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskFileResponseValidationError) Field() string { return e.field }
+func (e AdminDeleteUrlPolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskFileResponseValidationError) Reason() string { return e.reason }
+func (e AdminDeleteUrlPolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskFileResponseValidationError) Cause() error { return e.cause }
+func (e AdminDeleteUrlPolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskFileResponseValidationError) Key() bool { return e.key }
+func (e AdminDeleteUrlPolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskFileResponseValidationError) ErrorName() string {
-	return "GetDownloadTaskFileResponseValidationError"
+func (e AdminDeleteUrlPolicyResponseValidationError) ErrorName() string {
+	return "AdminDeleteUrlPolicyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskFileResponseValidationError) Error() string {
+func (e AdminDeleteUrlPolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1905,14 +8136,14 @@ func (e GetDownloadTaskFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskFileResponse.%s: %s%s",
+		"invalid %sAdminDeleteUrlPolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskFileResponseValidationError{}
+var _ error = AdminDeleteUrlPolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1920,4 +8151,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskFileResponseValidationError{}
+} = AdminDeleteUrlPolicyResponseValidationError{}
@@ -19,13 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GoLoadService_CreateAccount_FullMethodName       = "/goload.GoLoadService/CreateAccount"
-	GoLoadService_CreateSession_FullMethodName       = "/goload.GoLoadService/CreateSession"
-	GoLoadService_CreateDownloadTask_FullMethodName  = "/goload.GoLoadService/CreateDownloadTask"
-	GoLoadService_GetDownloadTaskList_FullMethodName = "/goload.GoLoadService/GetDownloadTaskList"
-	GoLoadService_UpdateDownloadTask_FullMethodName  = "/goload.GoLoadService/UpdateDownloadTask"
-	GoLoadService_DeleteDownloadTask_FullMethodName  = "/goload.GoLoadService/DeleteDownloadTask"
-	GoLoadService_GetDownloadTaskFile_FullMethodName = "/goload.GoLoadService/GetDownloadTaskFile"
+	GoLoadService_CreateAccount_FullMethodName                 = "/goload.GoLoadService/CreateAccount"
+	GoLoadService_CreateSession_FullMethodName                 = "/goload.GoLoadService/CreateSession"
+	GoLoadService_RefreshSession_FullMethodName                = "/goload.GoLoadService/RefreshSession"
+	GoLoadService_DeleteSession_FullMethodName                 = "/goload.GoLoadService/DeleteSession"
+	GoLoadService_ChangePassword_FullMethodName                = "/goload.GoLoadService/ChangePassword"
+	GoLoadService_RequestPasswordReset_FullMethodName          = "/goload.GoLoadService/RequestPasswordReset"
+	GoLoadService_ResetPassword_FullMethodName                 = "/goload.GoLoadService/ResetPassword"
+	GoLoadService_GetAccountUsage_FullMethodName               = "/goload.GoLoadService/GetAccountUsage"
+	GoLoadService_AdminGetDownloadTaskList_FullMethodName      = "/goload.GoLoadService/AdminGetDownloadTaskList"
+	GoLoadService_AdminFailDownloadTask_FullMethodName         = "/goload.GoLoadService/AdminFailDownloadTask"
+	GoLoadService_AdminRetryDownloadTask_FullMethodName        = "/goload.GoLoadService/AdminRetryDownloadTask"
+	GoLoadService_AdminDisableAccount_FullMethodName           = "/goload.GoLoadService/AdminDisableAccount"
+	GoLoadService_AdminUpdateAccountRole_FullMethodName        = "/goload.GoLoadService/AdminUpdateAccountRole"
+	GoLoadService_AdminUpdateAccountMaxFileSize_FullMethodName = "/goload.GoLoadService/AdminUpdateAccountMaxFileSize"
+	GoLoadService_AdminCreateUrlPolicy_FullMethodName          = "/goload.GoLoadService/AdminCreateUrlPolicy"
+	GoLoadService_AdminGetUrlPolicyList_FullMethodName         = "/goload.GoLoadService/AdminGetUrlPolicyList"
+	GoLoadService_AdminUpdateUrlPolicy_FullMethodName          = "/goload.GoLoadService/AdminUpdateUrlPolicy"
+	GoLoadService_AdminDeleteUrlPolicy_FullMethodName          = "/goload.GoLoadService/AdminDeleteUrlPolicy"
+	GoLoadService_CreateApiKey_FullMethodName                  = "/goload.GoLoadService/CreateApiKey"
+	GoLoadService_ListApiKeys_FullMethodName                   = "/goload.GoLoadService/ListApiKeys"
+	GoLoadService_RevokeApiKey_FullMethodName                  = "/goload.GoLoadService/RevokeApiKey"
+	GoLoadService_CreateDownloadTask_FullMethodName            = "/goload.GoLoadService/CreateDownloadTask"
+	GoLoadService_GetDownloadTaskList_FullMethodName           = "/goload.GoLoadService/GetDownloadTaskList"
+	GoLoadService_UpdateDownloadTask_FullMethodName            = "/goload.GoLoadService/UpdateDownloadTask"
+	GoLoadService_DeleteDownloadTask_FullMethodName            = "/goload.GoLoadService/DeleteDownloadTask"
+	GoLoadService_CancelDownloadTask_FullMethodName            = "/goload.GoLoadService/CancelDownloadTask"
+	GoLoadService_PauseDownloadTask_FullMethodName             = "/goload.GoLoadService/PauseDownloadTask"
+	GoLoadService_ResumeDownloadTask_FullMethodName            = "/goload.GoLoadService/ResumeDownloadTask"
+	GoLoadService_GetDownloadTaskFile_FullMethodName           = "/goload.GoLoadService/GetDownloadTaskFile"
+	GoLoadService_WatchDownloadTask_FullMethodName             = "/goload.GoLoadService/WatchDownloadTask"
 )
 
 // GoLoadServiceClient is the client API for GoLoadService service.
@@ -34,11 +57,37 @@ const (
 type GoLoadServiceClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
+	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	GetAccountUsage(ctx context.Context, in *GetAccountUsageRequest, opts ...grpc.CallOption) (*GetAccountUsageResponse, error)
+	// The admin methods require the operator role for download tasks and the admin role for accounts. The first
+	// admin has to be granted in the database.
+	AdminGetDownloadTaskList(ctx context.Context, in *AdminGetDownloadTaskListRequest, opts ...grpc.CallOption) (*AdminGetDownloadTaskListResponse, error)
+	AdminFailDownloadTask(ctx context.Context, in *AdminFailDownloadTaskRequest, opts ...grpc.CallOption) (*AdminFailDownloadTaskResponse, error)
+	AdminRetryDownloadTask(ctx context.Context, in *AdminRetryDownloadTaskRequest, opts ...grpc.CallOption) (*AdminRetryDownloadTaskResponse, error)
+	AdminDisableAccount(ctx context.Context, in *AdminDisableAccountRequest, opts ...grpc.CallOption) (*AdminDisableAccountResponse, error)
+	AdminUpdateAccountRole(ctx context.Context, in *AdminUpdateAccountRoleRequest, opts ...grpc.CallOption) (*AdminUpdateAccountRoleResponse, error)
+	AdminUpdateAccountMaxFileSize(ctx context.Context, in *AdminUpdateAccountMaxFileSizeRequest, opts ...grpc.CallOption) (*AdminUpdateAccountMaxFileSizeResponse, error)
+	// The url policy methods require the admin role.
+	AdminCreateUrlPolicy(ctx context.Context, in *AdminCreateUrlPolicyRequest, opts ...grpc.CallOption) (*AdminCreateUrlPolicyResponse, error)
+	AdminGetUrlPolicyList(ctx context.Context, in *AdminGetUrlPolicyListRequest, opts ...grpc.CallOption) (*AdminGetUrlPolicyListResponse, error)
+	AdminUpdateUrlPolicy(ctx context.Context, in *AdminUpdateUrlPolicyRequest, opts ...grpc.CallOption) (*AdminUpdateUrlPolicyResponse, error)
+	AdminDeleteUrlPolicy(ctx context.Context, in *AdminDeleteUrlPolicyRequest, opts ...grpc.CallOption) (*AdminDeleteUrlPolicyResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
 	CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error)
 	GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error)
 	UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error)
 	DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error)
+	CancelDownloadTask(ctx context.Context, in *CancelDownloadTaskRequest, opts ...grpc.CallOption) (*CancelDownloadTaskResponse, error)
+	PauseDownloadTask(ctx context.Context, in *PauseDownloadTaskRequest, opts ...grpc.CallOption) (*PauseDownloadTaskResponse, error)
+	ResumeDownloadTask(ctx context.Context, in *ResumeDownloadTaskRequest, opts ...grpc.CallOption) (*ResumeDownloadTaskResponse, error)
 	GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDownloadTaskFileResponse], error)
+	WatchDownloadTask(ctx context.Context, in *WatchDownloadTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDownloadTaskResponse], error)
 }
 
 type goLoadServiceClient struct {
@@ -69,6 +118,196 @@ func (c *goLoadServiceClient) CreateSession(ctx context.Context, in *CreateSessi
 	return out, nil
 }
 
+func (c *goLoadServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RefreshSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSessionResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_DeleteSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetAccountUsage(ctx context.Context, in *GetAccountUsageRequest, opts ...grpc.CallOption) (*GetAccountUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountUsageResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_GetAccountUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminGetDownloadTaskList(ctx context.Context, in *AdminGetDownloadTaskListRequest, opts ...grpc.CallOption) (*AdminGetDownloadTaskListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetDownloadTaskListResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminGetDownloadTaskList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminFailDownloadTask(ctx context.Context, in *AdminFailDownloadTaskRequest, opts ...grpc.CallOption) (*AdminFailDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminFailDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminFailDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminRetryDownloadTask(ctx context.Context, in *AdminRetryDownloadTaskRequest, opts ...grpc.CallOption) (*AdminRetryDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminRetryDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminRetryDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminDisableAccount(ctx context.Context, in *AdminDisableAccountRequest, opts ...grpc.CallOption) (*AdminDisableAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDisableAccountResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminDisableAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminUpdateAccountRole(ctx context.Context, in *AdminUpdateAccountRoleRequest, opts ...grpc.CallOption) (*AdminUpdateAccountRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUpdateAccountRoleResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminUpdateAccountRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminUpdateAccountMaxFileSize(ctx context.Context, in *AdminUpdateAccountMaxFileSizeRequest, opts ...grpc.CallOption) (*AdminUpdateAccountMaxFileSizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUpdateAccountMaxFileSizeResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminUpdateAccountMaxFileSize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminCreateUrlPolicy(ctx context.Context, in *AdminCreateUrlPolicyRequest, opts ...grpc.CallOption) (*AdminCreateUrlPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCreateUrlPolicyResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminCreateUrlPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminGetUrlPolicyList(ctx context.Context, in *AdminGetUrlPolicyListRequest, opts ...grpc.CallOption) (*AdminGetUrlPolicyListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetUrlPolicyListResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminGetUrlPolicyList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminUpdateUrlPolicy(ctx context.Context, in *AdminUpdateUrlPolicyRequest, opts ...grpc.CallOption) (*AdminUpdateUrlPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUpdateUrlPolicyResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminUpdateUrlPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AdminDeleteUrlPolicy(ctx context.Context, in *AdminDeleteUrlPolicyRequest, opts ...grpc.CallOption) (*AdminDeleteUrlPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDeleteUrlPolicyResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AdminDeleteUrlPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goLoadServiceClient) CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateDownloadTaskResponse)
@@ -109,6 +348,36 @@ func (c *goLoadServiceClient) DeleteDownloadTask(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *goLoadServiceClient) CancelDownloadTask(ctx context.Context, in *CancelDownloadTaskRequest, opts ...grpc.CallOption) (*CancelDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_CancelDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) PauseDownloadTask(ctx context.Context, in *PauseDownloadTaskRequest, opts ...grpc.CallOption) (*PauseDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_PauseDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ResumeDownloadTask(ctx context.Context, in *ResumeDownloadTaskRequest, opts ...grpc.CallOption) (*ResumeDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_ResumeDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goLoadServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDownloadTaskFileResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[0], GoLoadService_GetDownloadTaskFile_FullMethodName, cOpts...)
@@ -128,17 +397,62 @@ func (c *goLoadServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetDo
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type GoLoadService_GetDownloadTaskFileClient = grpc.ServerStreamingClient[GetDownloadTaskFileResponse]
 
+func (c *goLoadServiceClient) WatchDownloadTask(ctx context.Context, in *WatchDownloadTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDownloadTaskResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[1], GoLoadService_WatchDownloadTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDownloadTaskRequest, WatchDownloadTaskResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoLoadService_WatchDownloadTaskClient = grpc.ServerStreamingClient[WatchDownloadTaskResponse]
+
 // GoLoadServiceServer is the server API for GoLoadService service.
 // All implementations must embed UnimplementedGoLoadServiceServer
 // for forward compatibility.
 type GoLoadServiceServer interface {
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
 	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	GetAccountUsage(context.Context, *GetAccountUsageRequest) (*GetAccountUsageResponse, error)
+	// The admin methods require the operator role for download tasks and the admin role for accounts. The first
+	// admin has to be granted in the database.
+	AdminGetDownloadTaskList(context.Context, *AdminGetDownloadTaskListRequest) (*AdminGetDownloadTaskListResponse, error)
+	AdminFailDownloadTask(context.Context, *AdminFailDownloadTaskRequest) (*AdminFailDownloadTaskResponse, error)
+	AdminRetryDownloadTask(context.Context, *AdminRetryDownloadTaskRequest) (*AdminRetryDownloadTaskResponse, error)
+	AdminDisableAccount(context.Context, *AdminDisableAccountRequest) (*AdminDisableAccountResponse, error)
+	AdminUpdateAccountRole(context.Context, *AdminUpdateAccountRoleRequest) (*AdminUpdateAccountRoleResponse, error)
+	AdminUpdateAccountMaxFileSize(context.Context, *AdminUpdateAccountMaxFileSizeRequest) (*AdminUpdateAccountMaxFileSizeResponse, error)
+	// The url policy methods require the admin role.
+	AdminCreateUrlPolicy(context.Context, *AdminCreateUrlPolicyRequest) (*AdminCreateUrlPolicyResponse, error)
+	AdminGetUrlPolicyList(context.Context, *AdminGetUrlPolicyListRequest) (*AdminGetUrlPolicyListResponse, error)
+	AdminUpdateUrlPolicy(context.Context, *AdminUpdateUrlPolicyRequest) (*AdminUpdateUrlPolicyResponse, error)
+	AdminDeleteUrlPolicy(context.Context, *AdminDeleteUrlPolicyRequest) (*AdminDeleteUrlPolicyResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
 	CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error)
 	GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error)
 	UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error)
 	DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error)
+	CancelDownloadTask(context.Context, *CancelDownloadTaskRequest) (*CancelDownloadTaskResponse, error)
+	PauseDownloadTask(context.Context, *PauseDownloadTaskRequest) (*PauseDownloadTaskResponse, error)
+	ResumeDownloadTask(context.Context, *ResumeDownloadTaskRequest) (*ResumeDownloadTaskResponse, error)
 	GetDownloadTaskFile(*GetDownloadTaskFileRequest, grpc.ServerStreamingServer[GetDownloadTaskFileResponse]) error
+	WatchDownloadTask(*WatchDownloadTaskRequest, grpc.ServerStreamingServer[WatchDownloadTaskResponse]) error
 	mustEmbedUnimplementedGoLoadServiceServer()
 }
 
@@ -155,6 +469,63 @@ func (UnimplementedGoLoadServiceServer) CreateAccount(context.Context, *CreateAc
 func (UnimplementedGoLoadServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
 }
+func (UnimplementedGoLoadServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetAccountUsage(context.Context, *GetAccountUsageRequest) (*GetAccountUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountUsage not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminGetDownloadTaskList(context.Context, *AdminGetDownloadTaskListRequest) (*AdminGetDownloadTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetDownloadTaskList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminFailDownloadTask(context.Context, *AdminFailDownloadTaskRequest) (*AdminFailDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminFailDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminRetryDownloadTask(context.Context, *AdminRetryDownloadTaskRequest) (*AdminRetryDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRetryDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminDisableAccount(context.Context, *AdminDisableAccountRequest) (*AdminDisableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDisableAccount not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminUpdateAccountRole(context.Context, *AdminUpdateAccountRoleRequest) (*AdminUpdateAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateAccountRole not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminUpdateAccountMaxFileSize(context.Context, *AdminUpdateAccountMaxFileSizeRequest) (*AdminUpdateAccountMaxFileSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateAccountMaxFileSize not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminCreateUrlPolicy(context.Context, *AdminCreateUrlPolicyRequest) (*AdminCreateUrlPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateUrlPolicy not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminGetUrlPolicyList(context.Context, *AdminGetUrlPolicyListRequest) (*AdminGetUrlPolicyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetUrlPolicyList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminUpdateUrlPolicy(context.Context, *AdminUpdateUrlPolicyRequest) (*AdminUpdateUrlPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateUrlPolicy not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AdminDeleteUrlPolicy(context.Context, *AdminDeleteUrlPolicyRequest) (*AdminDeleteUrlPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeleteUrlPolicy not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
 func (UnimplementedGoLoadServiceServer) CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadTask not implemented")
 }
@@ -167,9 +538,21 @@ func (UnimplementedGoLoadServiceServer) UpdateDownloadTask(context.Context, *Upd
 func (UnimplementedGoLoadServiceServer) DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDownloadTask not implemented")
 }
+func (UnimplementedGoLoadServiceServer) CancelDownloadTask(context.Context, *CancelDownloadTaskRequest) (*CancelDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) PauseDownloadTask(context.Context, *PauseDownloadTaskRequest) (*PauseDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ResumeDownloadTask(context.Context, *ResumeDownloadTaskRequest) (*ResumeDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeDownloadTask not implemented")
+}
 func (UnimplementedGoLoadServiceServer) GetDownloadTaskFile(*GetDownloadTaskFileRequest, grpc.ServerStreamingServer[GetDownloadTaskFileResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskFile not implemented")
 }
+func (UnimplementedGoLoadServiceServer) WatchDownloadTask(*WatchDownloadTaskRequest, grpc.ServerStreamingServer[WatchDownloadTaskResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDownloadTask not implemented")
+}
 func (UnimplementedGoLoadServiceServer) mustEmbedUnimplementedGoLoadServiceServer() {}
 func (UnimplementedGoLoadServiceServer) testEmbeddedByValue()                       {}
 
@@ -227,104 +610,587 @@ func _GoLoadService_CreateSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDownloadTaskRequest)
+func _GoLoadService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, in)
+		return srv.(GoLoadServiceServer).RefreshSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_CreateDownloadTask_FullMethodName,
+		FullMethod: GoLoadService_RefreshSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+		return srv.(GoLoadServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDownloadTaskListRequest)
+func _GoLoadService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, in)
+		return srv.(GoLoadServiceServer).DeleteSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_GetDownloadTaskList_FullMethodName,
+		FullMethod: GoLoadService_DeleteSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+		return srv.(GoLoadServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDownloadTaskRequest)
+func _GoLoadService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, in)
+		return srv.(GoLoadServiceServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_UpdateDownloadTask_FullMethodName,
+		FullMethod: GoLoadService_ChangePassword_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+		return srv.(GoLoadServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDownloadTaskRequest)
+func _GoLoadService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, in)
+		return srv.(GoLoadServiceServer).RequestPasswordReset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_DeleteDownloadTask_FullMethodName,
+		FullMethod: GoLoadService_RequestPasswordReset_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+		return srv.(GoLoadServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetDownloadTaskFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoLoadService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoLoadServiceServer).GetDownloadTaskFile(m, &grpc.GenericServerStream[GetDownloadTaskFileRequest, GetDownloadTaskFileResponse]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type GoLoadService_GetDownloadTaskFileServer = grpc.ServerStreamingServer[GetDownloadTaskFileResponse]
+func _GoLoadService_GetAccountUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetAccountUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_GetAccountUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetAccountUsage(ctx, req.(*GetAccountUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// GoLoadService_ServiceDesc is the grpc.ServiceDesc for GoLoadService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var GoLoadService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "goload.GoLoadService",
-	HandlerType: (*GoLoadServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateAccount",
-			Handler:    _GoLoadService_CreateAccount_Handler,
-		},
-		{
-			MethodName: "CreateSession",
-			Handler:    _GoLoadService_CreateSession_Handler,
-		},
+func _GoLoadService_AdminGetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminGetDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminGetDownloadTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminGetDownloadTaskList(ctx, req.(*AdminGetDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminFailDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminFailDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminFailDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminFailDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminFailDownloadTask(ctx, req.(*AdminFailDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminRetryDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRetryDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminRetryDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminRetryDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminRetryDownloadTask(ctx, req.(*AdminRetryDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminDisableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDisableAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminDisableAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminDisableAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminDisableAccount(ctx, req.(*AdminDisableAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminUpdateAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminUpdateAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminUpdateAccountRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminUpdateAccountRole(ctx, req.(*AdminUpdateAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminUpdateAccountMaxFileSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateAccountMaxFileSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminUpdateAccountMaxFileSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminUpdateAccountMaxFileSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminUpdateAccountMaxFileSize(ctx, req.(*AdminUpdateAccountMaxFileSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminCreateUrlPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreateUrlPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminCreateUrlPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminCreateUrlPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminCreateUrlPolicy(ctx, req.(*AdminCreateUrlPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminGetUrlPolicyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetUrlPolicyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminGetUrlPolicyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminGetUrlPolicyList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminGetUrlPolicyList(ctx, req.(*AdminGetUrlPolicyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminUpdateUrlPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateUrlPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminUpdateUrlPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminUpdateUrlPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminUpdateUrlPolicy(ctx, req.(*AdminUpdateUrlPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AdminDeleteUrlPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteUrlPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AdminDeleteUrlPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_AdminDeleteUrlPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AdminDeleteUrlPolicy(ctx, req.(*AdminDeleteUrlPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CreateDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_GetDownloadTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_UpdateDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_DeleteDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CancelDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CancelDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CancelDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CancelDownloadTask(ctx, req.(*CancelDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_PauseDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).PauseDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_PauseDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).PauseDownloadTask(ctx, req.(*PauseDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ResumeDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ResumeDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_ResumeDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ResumeDownloadTask(ctx, req.(*ResumeDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDownloadTaskFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoLoadServiceServer).GetDownloadTaskFile(m, &grpc.GenericServerStream[GetDownloadTaskFileRequest, GetDownloadTaskFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoLoadService_GetDownloadTaskFileServer = grpc.ServerStreamingServer[GetDownloadTaskFileResponse]
+
+func _GoLoadService_WatchDownloadTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDownloadTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoLoadServiceServer).WatchDownloadTask(m, &grpc.GenericServerStream[WatchDownloadTaskRequest, WatchDownloadTaskResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoLoadService_WatchDownloadTaskServer = grpc.ServerStreamingServer[WatchDownloadTaskResponse]
+
+// GoLoadService_ServiceDesc is the grpc.ServiceDesc for GoLoadService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GoLoadService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "goload.GoLoadService",
+	HandlerType: (*GoLoadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _GoLoadService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _GoLoadService_CreateSession_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _GoLoadService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _GoLoadService_DeleteSession_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _GoLoadService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _GoLoadService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _GoLoadService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "GetAccountUsage",
+			Handler:    _GoLoadService_GetAccountUsage_Handler,
+		},
+		{
+			MethodName: "AdminGetDownloadTaskList",
+			Handler:    _GoLoadService_AdminGetDownloadTaskList_Handler,
+		},
+		{
+			MethodName: "AdminFailDownloadTask",
+			Handler:    _GoLoadService_AdminFailDownloadTask_Handler,
+		},
+		{
+			MethodName: "AdminRetryDownloadTask",
+			Handler:    _GoLoadService_AdminRetryDownloadTask_Handler,
+		},
+		{
+			MethodName: "AdminDisableAccount",
+			Handler:    _GoLoadService_AdminDisableAccount_Handler,
+		},
+		{
+			MethodName: "AdminUpdateAccountRole",
+			Handler:    _GoLoadService_AdminUpdateAccountRole_Handler,
+		},
+		{
+			MethodName: "AdminUpdateAccountMaxFileSize",
+			Handler:    _GoLoadService_AdminUpdateAccountMaxFileSize_Handler,
+		},
+		{
+			MethodName: "AdminCreateUrlPolicy",
+			Handler:    _GoLoadService_AdminCreateUrlPolicy_Handler,
+		},
+		{
+			MethodName: "AdminGetUrlPolicyList",
+			Handler:    _GoLoadService_AdminGetUrlPolicyList_Handler,
+		},
+		{
+			MethodName: "AdminUpdateUrlPolicy",
+			Handler:    _GoLoadService_AdminUpdateUrlPolicy_Handler,
+		},
+		{
+			MethodName: "AdminDeleteUrlPolicy",
+			Handler:    _GoLoadService_AdminDeleteUrlPolicy_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _GoLoadService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _GoLoadService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _GoLoadService_RevokeApiKey_Handler,
+		},
 		{
 			MethodName: "CreateDownloadTask",
 			Handler:    _GoLoadService_CreateDownloadTask_Handler,
@@ -341,6 +1207,18 @@ var GoLoadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDownloadTask",
 			Handler:    _GoLoadService_DeleteDownloadTask_Handler,
 		},
+		{
+			MethodName: "CancelDownloadTask",
+			Handler:    _GoLoadService_CancelDownloadTask_Handler,
+		},
+		{
+			MethodName: "PauseDownloadTask",
+			Handler:    _GoLoadService_PauseDownloadTask_Handler,
+		},
+		{
+			MethodName: "ResumeDownloadTask",
+			Handler:    _GoLoadService_ResumeDownloadTask_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -348,6 +1226,11 @@ var GoLoadService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoLoadService_GetDownloadTaskFile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchDownloadTask",
+			Handler:       _GoLoadService_WatchDownloadTask_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "goload.proto",
 }
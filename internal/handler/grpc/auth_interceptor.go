@@ -0,0 +1,199 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/generated/grpc/goload"
+	"goload/internal/logic"
+	"goload/internal/utils"
+)
+
+const (
+	AuthTokenMetadataName = "goload-auth"
+	// grpc-gateway forwards the Authorization header of REST requests under this name.
+	authorizationMetadataName = "authorization"
+	bearerAuthorizationPrefix = "Bearer "
+)
+
+var (
+	errApiKeyMethodNotAllowed = status.Error(codes.PermissionDenied, "api keys can not call this method")
+	errApiKeyScopeMissing     = status.Error(codes.PermissionDenied, "api key does not have the scope this method requires")
+	errPermissionDenied       = status.Error(codes.PermissionDenied, "account does not have the permission this method requires")
+)
+
+// publicMethods are the methods that can be called without an auth token. Every other method is rejected unless
+// the caller is authenticated. The session methods are authenticated by the refresh token in their request
+// instead, since they are called once the access token has expired, and resetting a password by the reset token.
+var publicMethods = map[string]struct{}{
+	goload.GoLoadService_CreateAccount_FullMethodName:        {},
+	goload.GoLoadService_CreateSession_FullMethodName:        {},
+	goload.GoLoadService_RefreshSession_FullMethodName:       {},
+	goload.GoLoadService_DeleteSession_FullMethodName:        {},
+	goload.GoLoadService_RequestPasswordReset_FullMethodName: {},
+	goload.GoLoadService_ResetPassword_FullMethodName:        {},
+}
+
+// apiKeyMethodScopes are the methods that api keys can call, along with the scope each of them requires.
+var apiKeyMethodScopes = map[string]goload.ApiKeyScope{
+	goload.GoLoadService_GetDownloadTaskList_FullMethodName: goload.ApiKeyScope_ReadDownloadTasks,
+	goload.GoLoadService_GetDownloadTaskFile_FullMethodName: goload.ApiKeyScope_ReadDownloadTasks,
+	goload.GoLoadService_WatchDownloadTask_FullMethodName:   goload.ApiKeyScope_ReadDownloadTasks,
+	goload.GoLoadService_GetAccountUsage_FullMethodName:     goload.ApiKeyScope_ReadDownloadTasks,
+	goload.GoLoadService_CreateDownloadTask_FullMethodName:  goload.ApiKeyScope_WriteDownloadTasks,
+	goload.GoLoadService_UpdateDownloadTask_FullMethodName:  goload.ApiKeyScope_WriteDownloadTasks,
+	goload.GoLoadService_DeleteDownloadTask_FullMethodName:  goload.ApiKeyScope_WriteDownloadTasks,
+	goload.GoLoadService_CancelDownloadTask_FullMethodName:  goload.ApiKeyScope_WriteDownloadTasks,
+	goload.GoLoadService_PauseDownloadTask_FullMethodName:   goload.ApiKeyScope_WriteDownloadTasks,
+	goload.GoLoadService_ResumeDownloadTask_FullMethodName:  goload.ApiKeyScope_WriteDownloadTasks,
+}
+
+// methodPermissions are the methods that require a permission on top of being authenticated. Api keys can not call
+// any of them.
+var methodPermissions = map[string]logic.Permission{
+	goload.GoLoadService_AdminGetDownloadTaskList_FullMethodName:      logic.PermissionManageAnyDownloadTask,
+	goload.GoLoadService_AdminFailDownloadTask_FullMethodName:         logic.PermissionManageAnyDownloadTask,
+	goload.GoLoadService_AdminRetryDownloadTask_FullMethodName:        logic.PermissionManageAnyDownloadTask,
+	goload.GoLoadService_AdminDisableAccount_FullMethodName:           logic.PermissionManageAccounts,
+	goload.GoLoadService_AdminUpdateAccountRole_FullMethodName:        logic.PermissionManageAccounts,
+	goload.GoLoadService_AdminUpdateAccountMaxFileSize_FullMethodName: logic.PermissionManageAccounts,
+	goload.GoLoadService_AdminCreateUrlPolicy_FullMethodName:          logic.PermissionManageURLPolicies,
+	goload.GoLoadService_AdminGetUrlPolicyList_FullMethodName:         logic.PermissionManageURLPolicies,
+	goload.GoLoadService_AdminUpdateUrlPolicy_FullMethodName:          logic.PermissionManageURLPolicies,
+	goload.GoLoadService_AdminDeleteUrlPolicy_FullMethodName:          logic.PermissionManageURLPolicies,
+}
+
+type AuthInterceptor interface {
+	UnaryServerInterceptor() grpc.UnaryServerInterceptor
+	StreamServerInterceptor() grpc.StreamServerInterceptor
+}
+
+type authInterceptor struct {
+	tokenService  logic.TokenService
+	apiKeyService logic.ApiKeyService
+	logger        *zap.Logger
+}
+
+func NewAuthInterceptor(
+	tokenService logic.TokenService,
+	apiKeyService logic.ApiKeyService,
+	logger *zap.Logger,
+) AuthInterceptor {
+	return &authInterceptor{
+		tokenService:  tokenService,
+		apiKeyService: apiKeyService,
+		logger:        logger,
+	}
+}
+
+// authenticatedServerStream overrides the context of a stream with one that carries the authenticated caller.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (a authenticatedServerStream) Context() context.Context {
+	return a.ctx
+}
+
+// UnaryServerInterceptor implements AuthInterceptor.
+func (a authInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor implements AuthInterceptor.
+func (a authInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, authenticatedServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticate returns ctx with the ID of the account the access token or api key belongs to. Public methods are
+// let through as is.
+func (a authInterceptor) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if _, ok := publicMethods[fullMethod]; ok {
+		return ctx, nil
+	}
+
+	credential := a.getAuthCredential(ctx)
+	if a.apiKeyService.IsApiKey(credential) {
+		return a.authenticateApiKey(ctx, fullMethod, credential)
+	}
+
+	claims, err := a.tokenService.ParseToken(ctx, credential)
+	if err != nil {
+		utils.LoggerWithContext(ctx, a.logger).
+			With(zap.String("method", fullMethod)).
+			With(zap.Error(err)).
+			Debug("failed to authenticate request")
+		return nil, err
+	}
+
+	// The role in the token may be outdated, logic checks the permission again against the stored role.
+	if requiredPermission, ok := methodPermissions[fullMethod]; ok && !logic.HasPermission(claims.Role, requiredPermission) {
+		return nil, errPermissionDenied
+	}
+
+	return logic.ContextWithAccountID(ctx, claims.AccountID), nil
+}
+
+func (a authInterceptor) authenticateApiKey(ctx context.Context, fullMethod string, key string) (context.Context, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("method", fullMethod))
+
+	requiredScope, ok := apiKeyMethodScopes[fullMethod]
+	if !ok {
+		return nil, errApiKeyMethodNotAllowed
+	}
+
+	output, err := a.apiKeyService.AuthenticateApiKey(ctx, key)
+	if err != nil {
+		logger.With(zap.Error(err)).Debug("failed to authenticate api key")
+		return nil, err
+	}
+
+	if !slices.Contains(output.Scopes, requiredScope) {
+		return nil, errApiKeyScopeMissing
+	}
+
+	return logic.ContextWithAccountID(ctx, output.AccountID), nil
+}
+
+// getAuthCredential returns the access token or api key of the request, taken from the goload-auth metadata or
+// else from a bearer Authorization header.
+func (a authInterceptor) getAuthCredential(ctx context.Context) string {
+	metadata, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if metadataValues := metadata.Get(AuthTokenMetadataName); len(metadataValues) > 0 {
+		return metadataValues[0]
+	}
+
+	for _, authorization := range metadata.Get(authorizationMetadataName) {
+		if credential, ok := strings.CutPrefix(authorization, bearerAuthorizationPrefix); ok {
+			return credential
+		}
+	}
+
+	return ""
+}
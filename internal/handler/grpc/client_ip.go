@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	forwardedForMetadataName = "x-forwarded-for"
+)
+
+// getClientIP returns the IP of the client that made the call, or an empty string if it is not known. Calls coming
+// through the HTTP gateway arrive from loopback, so for those the last X-Forwarded-For entry, which the gateway
+// appends itself, is used instead. Earlier entries are set by the client and can not be trusted.
+func getClientIP(ctx context.Context) string {
+	callPeer, ok := peer.FromContext(ctx)
+	if !ok || callPeer.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(callPeer.Addr.String())
+	if err != nil {
+		return ""
+	}
+
+	peerIP := net.ParseIP(host)
+	if peerIP == nil || !peerIP.IsLoopback() {
+		return host
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return host
+	}
+
+	forwardedForList := md.Get(forwardedForMetadataName)
+	if len(forwardedForList) == 0 {
+		return host
+	}
+
+	forwardedFor := strings.Split(forwardedForList[len(forwardedForList)-1], ",")
+	if forwardedIP := strings.TrimSpace(forwardedFor[len(forwardedFor)-1]); net.ParseIP(forwardedIP) != nil {
+		return forwardedIP
+	}
+
+	return host
+}
@@ -2,34 +2,47 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"goload/internal/generated/grpc/goload"
 	"goload/internal/logic"
 )
 
 const (
-	AuthTokenMetadataName = "goload-auth"
+	downloadTaskFileChunkSizeInBytes = 64 * 1024
+	downloadTaskWatchInterval        = time.Second
+)
+
+var (
+	errUnauthenticated = status.Error(codes.Unauthenticated, "request is not authenticated")
 )
 
 type Handler struct {
 	goload.UnimplementedGoLoadServiceServer
 	accountService      logic.AccountService
+	apiKeyService       logic.ApiKeyService
 	downloadTaskService logic.DownloadTaskService
-	tokenService        logic.TokenService
+	urlPolicyService    logic.URLPolicyService
 }
 
 func NewHandler(
 	accountService logic.AccountService,
+	apiKeyService logic.ApiKeyService,
 	downloadTaskService logic.DownloadTaskService,
-	tokenService logic.TokenService,
+	urlPolicyService logic.URLPolicyService,
 ) goload.GoLoadServiceServer {
 	return &Handler{
 		accountService:      accountService,
+		apiKeyService:       apiKeyService,
 		downloadTaskService: downloadTaskService,
-		tokenService:        tokenService,
+		urlPolicyService:    urlPolicyService,
 	}
 }
 
@@ -53,6 +66,7 @@ func (h *Handler) CreateSession(ctx context.Context, request *goload.CreateSessi
 	output, err := h.accountService.CreateSession(ctx, logic.CreateSessionInput{
 		AccountName: request.GetAccountName(),
 		Password:    request.GetPassword(),
+		ClientIP:    getClientIP(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -61,21 +75,441 @@ func (h *Handler) CreateSession(ctx context.Context, request *goload.CreateSessi
 	return &goload.CreateSessionResponse{
 		Account: &goload.Account{
 			Id:          output.Account.Id,
-			AccountName: output.Account.AccountName},
-		Token: output.Token,
+			AccountName: output.Account.AccountName,
+			Role:        output.Account.Role},
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	}, nil
+}
+
+// RefreshSession implements goload.GoLoadServiceServer.
+func (h *Handler) RefreshSession(ctx context.Context, request *goload.RefreshSessionRequest) (*goload.RefreshSessionResponse, error) {
+	output, err := h.accountService.RefreshSession(ctx, logic.RefreshSessionInput{
+		RefreshToken: request.GetRefreshToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.RefreshSessionResponse{
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	}, nil
+}
+
+// DeleteSession implements goload.GoLoadServiceServer.
+func (h *Handler) DeleteSession(ctx context.Context, request *goload.DeleteSessionRequest) (*goload.DeleteSessionResponse, error) {
+	output, err := h.accountService.DeleteSession(ctx, logic.DeleteSessionInput{
+		RefreshToken: request.GetRefreshToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.DeleteSessionResponse{
+		Deleted: output.Deleted,
+	}, nil
+}
+
+// ChangePassword implements goload.GoLoadServiceServer.
+func (h *Handler) ChangePassword(ctx context.Context, request *goload.ChangePasswordRequest) (*goload.ChangePasswordResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.accountService.ChangePassword(ctx, logic.ChangePasswordInput{
+		AccountID:   accountID,
+		OldPassword: request.GetOldPassword(),
+		NewPassword: request.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.ChangePasswordResponse{
+		Changed: output.Changed,
+	}, nil
+}
+
+// RequestPasswordReset implements goload.GoLoadServiceServer.
+func (h *Handler) RequestPasswordReset(
+	ctx context.Context,
+	request *goload.RequestPasswordResetRequest,
+) (*goload.RequestPasswordResetResponse, error) {
+	if _, err := h.accountService.RequestPasswordReset(ctx, logic.RequestPasswordResetInput{
+		AccountName: request.GetAccountName(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &goload.RequestPasswordResetResponse{}, nil
+}
+
+// ResetPassword implements goload.GoLoadServiceServer.
+func (h *Handler) ResetPassword(ctx context.Context, request *goload.ResetPasswordRequest) (*goload.ResetPasswordResponse, error) {
+	output, err := h.accountService.ResetPassword(ctx, logic.ResetPasswordInput{
+		ResetToken:  request.GetResetToken(),
+		NewPassword: request.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.ResetPasswordResponse{
+		Changed: output.Changed,
+	}, nil
+}
+
+// GetAccountUsage implements goload.GoLoadServiceServer.
+func (h *Handler) GetAccountUsage(ctx context.Context, _ *goload.GetAccountUsageRequest) (*goload.GetAccountUsageResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.downloadTaskService.GetAccountUsage(ctx, logic.GetAccountUsageInput{
+		OfAccountID: accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.GetAccountUsageResponse{
+		AccountUsage: output.AccountUsage,
+	}, nil
+}
+
+// AdminGetDownloadTaskList implements goload.GoLoadServiceServer.
+func (h *Handler) AdminGetDownloadTaskList(
+	ctx context.Context,
+	request *goload.AdminGetDownloadTaskListRequest,
+) (*goload.AdminGetDownloadTaskListResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.downloadTaskService.AdminGetDownloadTaskList(ctx, logic.AdminGetDownloadTaskListInput{
+		OfAccountID:     accountID,
+		FilterAccountID: request.GetOfAccountId(),
+		Offset:          request.GetOffset(),
+		Limit:           request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminGetDownloadTaskListResponse{
+		DownloadTaskList:       output.DownloadTaskList,
+		TotalDownloadTaskCount: output.TotalDownloadTaskCount,
+	}, nil
+}
+
+// AdminFailDownloadTask implements goload.GoLoadServiceServer.
+func (h *Handler) AdminFailDownloadTask(
+	ctx context.Context,
+	request *goload.AdminFailDownloadTaskRequest,
+) (*goload.AdminFailDownloadTaskResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.downloadTaskService.FailDownloadTask(ctx, logic.FailDownloadTaskInput{
+		OfAccountID:    accountID,
+		DownloadTaskID: request.GetId(),
+		Reason:         request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminFailDownloadTaskResponse{
+		Failed: output.Failed,
+	}, nil
+}
+
+// AdminRetryDownloadTask implements goload.GoLoadServiceServer.
+func (h *Handler) AdminRetryDownloadTask(
+	ctx context.Context,
+	request *goload.AdminRetryDownloadTaskRequest,
+) (*goload.AdminRetryDownloadTaskResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.downloadTaskService.RetryDownloadTask(ctx, logic.RetryDownloadTaskInput{
+		OfAccountID:    accountID,
+		DownloadTaskID: request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminRetryDownloadTaskResponse{
+		Retried: output.Retried,
+	}, nil
+}
+
+// AdminDisableAccount implements goload.GoLoadServiceServer.
+func (h *Handler) AdminDisableAccount(
+	ctx context.Context,
+	request *goload.AdminDisableAccountRequest,
+) (*goload.AdminDisableAccountResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.accountService.DisableAccount(ctx, logic.DisableAccountInput{
+		AdminAccountID: accountID,
+		AccountID:      request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminDisableAccountResponse{
+		Disabled: output.Disabled,
+	}, nil
+}
+
+// AdminUpdateAccountRole implements goload.GoLoadServiceServer.
+func (h *Handler) AdminUpdateAccountRole(
+	ctx context.Context,
+	request *goload.AdminUpdateAccountRoleRequest,
+) (*goload.AdminUpdateAccountRoleResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.accountService.UpdateAccountRole(ctx, logic.UpdateAccountRoleInput{
+		AdminAccountID: accountID,
+		AccountID:      request.GetId(),
+		Role:           request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminUpdateAccountRoleResponse{
+		Updated: output.Updated,
+	}, nil
+}
+
+// AdminUpdateAccountMaxFileSize implements goload.GoLoadServiceServer.
+func (h *Handler) AdminUpdateAccountMaxFileSize(
+	ctx context.Context,
+	request *goload.AdminUpdateAccountMaxFileSizeRequest,
+) (*goload.AdminUpdateAccountMaxFileSizeResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.accountService.UpdateAccountMaxFileSize(ctx, logic.UpdateAccountMaxFileSizeInput{
+		AdminAccountID:     accountID,
+		AccountID:          request.GetId(),
+		MaxFileSizeInBytes: request.GetMaxFileSizeInBytes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminUpdateAccountMaxFileSizeResponse{
+		Updated: output.Updated,
+	}, nil
+}
+
+// AdminCreateUrlPolicy implements goload.GoLoadServiceServer.
+func (h *Handler) AdminCreateUrlPolicy(
+	ctx context.Context,
+	request *goload.AdminCreateUrlPolicyRequest,
+) (*goload.AdminCreateUrlPolicyResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.urlPolicyService.CreateURLPolicy(ctx, logic.CreateURLPolicyInput{
+		AdminAccountID: accountID,
+		OfAccountID:    request.GetOfAccountId(),
+		Action:         request.GetAction(),
+		HostGlob:       request.GetHostGlob(),
+		URLRegex:       request.GetUrlRegex(),
+		Scheme:         request.GetScheme(),
+		Port:           request.GetPort(),
+		Description:    request.GetDescription(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminCreateUrlPolicyResponse{
+		UrlPolicy: output.URLPolicy,
+	}, nil
+}
+
+// AdminGetUrlPolicyList implements goload.GoLoadServiceServer.
+func (h *Handler) AdminGetUrlPolicyList(
+	ctx context.Context,
+	request *goload.AdminGetUrlPolicyListRequest,
+) (*goload.AdminGetUrlPolicyListResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.urlPolicyService.GetURLPolicyList(ctx, logic.GetURLPolicyListInput{
+		AdminAccountID:  accountID,
+		FilterAccountID: request.GetOfAccountId(),
+		Offset:          request.GetOffset(),
+		Limit:           request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminGetUrlPolicyListResponse{
+		UrlPolicyList:       output.URLPolicyList,
+		TotalUrlPolicyCount: output.TotalURLPolicyCount,
+	}, nil
+}
+
+// AdminUpdateUrlPolicy implements goload.GoLoadServiceServer.
+func (h *Handler) AdminUpdateUrlPolicy(
+	ctx context.Context,
+	request *goload.AdminUpdateUrlPolicyRequest,
+) (*goload.AdminUpdateUrlPolicyResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.urlPolicyService.UpdateURLPolicy(ctx, logic.UpdateURLPolicyInput{
+		AdminAccountID: accountID,
+		URLPolicyID:    request.GetId(),
+		Action:         request.GetAction(),
+		HostGlob:       request.GetHostGlob(),
+		URLRegex:       request.GetUrlRegex(),
+		Scheme:         request.GetScheme(),
+		Port:           request.GetPort(),
+		Description:    request.GetDescription(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminUpdateUrlPolicyResponse{
+		UrlPolicy: output.URLPolicy,
+	}, nil
+}
+
+// AdminDeleteUrlPolicy implements goload.GoLoadServiceServer.
+func (h *Handler) AdminDeleteUrlPolicy(
+	ctx context.Context,
+	request *goload.AdminDeleteUrlPolicyRequest,
+) (*goload.AdminDeleteUrlPolicyResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.urlPolicyService.DeleteURLPolicy(ctx, logic.DeleteURLPolicyInput{
+		AdminAccountID: accountID,
+		URLPolicyID:    request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.AdminDeleteUrlPolicyResponse{
+		Deleted: output.Deleted,
+	}, nil
+}
+
+// CreateApiKey implements goload.GoLoadServiceServer.
+func (h *Handler) CreateApiKey(ctx context.Context, request *goload.CreateApiKeyRequest) (*goload.CreateApiKeyResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := logic.CreateApiKeyInput{
+		OfAccountID: accountID,
+		Name:        request.GetName(),
+		Scopes:      request.GetScopes(),
+	}
+	if request.GetExpiresAt() != nil {
+		expiresAt := request.GetExpiresAt().AsTime()
+		input.ExpiresAt = &expiresAt
+	}
+
+	output, err := h.apiKeyService.CreateApiKey(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.CreateApiKeyResponse{
+		ApiKey: output.ApiKey,
+		Key:    output.Key,
+	}, nil
+}
+
+// ListApiKeys implements goload.GoLoadServiceServer.
+func (h *Handler) ListApiKeys(ctx context.Context, _ *goload.ListApiKeysRequest) (*goload.ListApiKeysResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.apiKeyService.ListApiKeys(ctx, logic.ListApiKeysInput{
+		OfAccountID: accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.ListApiKeysResponse{
+		ApiKeyList: output.ApiKeyList,
+	}, nil
+}
+
+// RevokeApiKey implements goload.GoLoadServiceServer.
+func (h *Handler) RevokeApiKey(ctx context.Context, request *goload.RevokeApiKeyRequest) (*goload.RevokeApiKeyResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.apiKeyService.RevokeApiKey(ctx, logic.RevokeApiKeyInput{
+		OfAccountID: accountID,
+		ApiKeyID:    request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.RevokeApiKeyResponse{
+		Revoked: output.Revoked,
 	}, nil
 }
 
 // CreateDownloadTask implements goload.GoLoadServiceServer.
 func (h *Handler) CreateDownloadTask(ctx context.Context, request *goload.CreateDownloadTaskRequest) (*goload.CreateDownloadTaskResponse, error) {
-	accountID, _, err := h.tokenService.ParseAccountIDAndExpireTime(ctx, h.getAuthTokenMetadata(ctx))
+	accountID, err := h.getAuthenticatedAccountID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	output, err := h.downloadTaskService.CreateDownloadTask(ctx, logic.CreateDownloadTaskInput{
-		OfAccountID: accountID,
-		URL:         request.GetUrl(),
+		OfAccountID:        accountID,
+		URL:                request.GetUrl(),
+		MaxFileSizeInBytes: request.GetMaxFileSizeInBytes(),
+		ExpectedChecksum:   request.GetExpectedChecksum(),
 	})
 	if err != nil {
 		return nil, err
@@ -88,7 +522,7 @@ func (h *Handler) CreateDownloadTask(ctx context.Context, request *goload.Create
 
 // DeleteDownloadTask implements goload.GoLoadServiceServer.
 func (h *Handler) DeleteDownloadTask(ctx context.Context, request *goload.DeleteDownloadTaskRequest) (*goload.DeleteDownloadTaskResponse, error) {
-	accountID, _, err := h.tokenService.ParseAccountIDAndExpireTime(ctx, h.getAuthTokenMetadata(ctx))
+	accountID, err := h.getAuthenticatedAccountID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -106,14 +540,116 @@ func (h *Handler) DeleteDownloadTask(ctx context.Context, request *goload.Delete
 	}, nil
 }
 
+// CancelDownloadTask implements goload.GoLoadServiceServer.
+func (h *Handler) CancelDownloadTask(ctx context.Context, request *goload.CancelDownloadTaskRequest) (*goload.CancelDownloadTaskResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.downloadTaskService.CancelDownloadTask(ctx, logic.CancelDownloadTaskInput{
+		OfAccountID:    accountID,
+		DownloadTaskID: request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.CancelDownloadTaskResponse{
+		Canceled: output.Canceled,
+	}, nil
+}
+
+// PauseDownloadTask implements goload.GoLoadServiceServer.
+func (h *Handler) PauseDownloadTask(ctx context.Context, request *goload.PauseDownloadTaskRequest) (*goload.PauseDownloadTaskResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.downloadTaskService.PauseDownloadTask(ctx, logic.PauseDownloadTaskInput{
+		OfAccountID:    accountID,
+		DownloadTaskID: request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.PauseDownloadTaskResponse{
+		Paused: output.Paused,
+	}, nil
+}
+
+// ResumeDownloadTask implements goload.GoLoadServiceServer.
+func (h *Handler) ResumeDownloadTask(ctx context.Context, request *goload.ResumeDownloadTaskRequest) (*goload.ResumeDownloadTaskResponse, error) {
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := h.downloadTaskService.ResumeDownloadTask(ctx, logic.ResumeDownloadTaskInput{
+		OfAccountID:    accountID,
+		DownloadTaskID: request.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &goload.ResumeDownloadTaskResponse{
+		Resumed: output.Resumed,
+	}, nil
+}
+
 // GetDownloadTaskFile implements goload.GoLoadServiceServer.
-func (h *Handler) GetDownloadTaskFile(*goload.GetDownloadTaskFileRequest, grpc.ServerStreamingServer[goload.GetDownloadTaskFileResponse]) error {
-	panic("unimplemented")
+func (h *Handler) GetDownloadTaskFile(
+	request *goload.GetDownloadTaskFileRequest,
+	stream grpc.ServerStreamingServer[goload.GetDownloadTaskFileResponse],
+) error {
+	ctx := stream.Context()
+
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	fileReadCloser, err := h.downloadTaskService.GetDownloadTaskFile(ctx, logic.GetDownloadTaskFileInput{
+		OfAccountID:    accountID,
+		DownloadTaskID: request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return err
+	}
+	defer fileReadCloser.Close()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// A fresh buffer is used for every chunk since grpc may still hold onto the sent message.
+		buffer := make([]byte, downloadTaskFileChunkSizeInBytes)
+		readByteCount, readErr := fileReadCloser.Read(buffer)
+		if readByteCount > 0 {
+			// Send blocks until the client has room for the message, which gives us backpressure.
+			if err := stream.Send(&goload.GetDownloadTaskFileResponse{
+				Data: buffer[:readByteCount],
+			}); err != nil {
+				return err
+			}
+		}
+
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) {
+				return nil
+			}
+			return readErr
+		}
+	}
 }
 
 // GetDownloadTaskList implements goload.GoLoadServiceServer.
 func (h *Handler) GetDownloadTaskList(ctx context.Context, request *goload.GetDownloadTaskListRequest) (*goload.GetDownloadTaskListResponse, error) {
-	accountID, _, err := h.tokenService.ParseAccountIDAndExpireTime(ctx, h.getAuthTokenMetadata(ctx))
+	accountID, err := h.getAuthenticatedAccountID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +671,7 @@ func (h *Handler) GetDownloadTaskList(ctx context.Context, request *goload.GetDo
 
 // UpdateDownloadTask implements goload.GoLoadServiceServer.
 func (h *Handler) UpdateDownloadTask(ctx context.Context, request *goload.UpdateDownloadTaskRequest) (*goload.UpdateDownloadTaskResponse, error) {
-	accountID, _, err := h.tokenService.ParseAccountIDAndExpireTime(ctx, h.getAuthTokenMetadata(ctx))
+	accountID, err := h.getAuthenticatedAccountID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -155,16 +691,58 @@ func (h *Handler) UpdateDownloadTask(ctx context.Context, request *goload.Update
 	}, nil
 }
 
-func (a Handler) getAuthTokenMetadata(ctx context.Context) string {
-	metadata, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return ""
+// WatchDownloadTask implements goload.GoLoadServiceServer.
+func (h *Handler) WatchDownloadTask(
+	request *goload.WatchDownloadTaskRequest,
+	stream grpc.ServerStreamingServer[goload.WatchDownloadTaskResponse],
+) error {
+	ctx := stream.Context()
+
+	accountID, err := h.getAuthenticatedAccountID(ctx)
+	if err != nil {
+		return err
 	}
 
-	metadataValues := metadata.Get(AuthTokenMetadataName)
-	if len(metadataValues) == 0 {
-		return ""
+	ticker := time.NewTicker(downloadTaskWatchInterval)
+	defer ticker.Stop()
+
+	var lastDownloadTask *goload.DownloadTask
+	for {
+		output, err := h.downloadTaskService.GetDownloadTask(ctx, logic.GetDownloadTaskInput{
+			OfAccountID:    accountID,
+			DownloadTaskID: request.GetId(),
+		})
+		if err != nil {
+			return err
+		}
+
+		// Only changes are sent, a paused task does not flood the stream with the same progress.
+		if !proto.Equal(lastDownloadTask, output.DownloadTask) {
+			if err = stream.Send(&goload.WatchDownloadTaskResponse{DownloadTask: output.DownloadTask}); err != nil {
+				return err
+			}
+			lastDownloadTask = output.DownloadTask
+		}
+
+		switch output.DownloadTask.GetDownloadStatus() {
+		case goload.DownloadStatus_Success, goload.DownloadStatus_Failed, goload.DownloadStatus_Canceled:
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// getAuthenticatedAccountID returns the caller that the auth interceptor put into ctx.
+func (h Handler) getAuthenticatedAccountID(ctx context.Context) (uint64, error) {
+	accountID, ok := logic.AccountIDFromContext(ctx)
+	if !ok {
+		return 0, errUnauthenticated
 	}
 
-	return metadataValues[0]
+	return accountID, nil
 }
@@ -18,21 +18,27 @@ type Server interface {
 }
 
 type server struct {
-	handler    goload.GoLoadServiceServer
-	grpcConfig configs.GRPC
-	grpcServer *grpc.Server
-	logger     *zap.Logger
+	handler               goload.GoLoadServiceServer
+	authInterceptor       AuthInterceptor
+	validationInterceptor ValidationInterceptor
+	grpcConfig            configs.GRPC
+	grpcServer            *grpc.Server
+	logger                *zap.Logger
 }
 
 func NewServer(
 	handler goload.GoLoadServiceServer,
+	authInterceptor AuthInterceptor,
+	validationInterceptor ValidationInterceptor,
 	grpcConfig configs.GRPC,
 	logger *zap.Logger,
 ) Server {
 	return &server{
-		handler:    handler,
-		grpcConfig: grpcConfig,
-		logger:     logger,
+		handler:               handler,
+		authInterceptor:       authInterceptor,
+		validationInterceptor: validationInterceptor,
+		grpcConfig:            grpcConfig,
+		logger:                logger,
 	}
 }
 
@@ -44,7 +50,17 @@ func (s *server) Start(ctx context.Context) error {
 		return err
 	}
 
-	server := grpc.NewServer()
+	// Requests are authenticated before they are validated, so that anonymous callers learn nothing about them.
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			s.authInterceptor.UnaryServerInterceptor(),
+			s.validationInterceptor.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			s.authInterceptor.StreamServerInterceptor(),
+			s.validationInterceptor.StreamServerInterceptor(),
+		),
+	)
 	s.grpcServer = server
 	goload.RegisterGoLoadServiceServer(server, s.handler)
 
@@ -0,0 +1,167 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/utils"
+)
+
+const (
+	requestValidationFailedMessage = "request validation failed"
+)
+
+// validatorAll is implemented by every message generated by protoc-gen-validate.
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// multiValidationError is implemented by the XMultiError types generated by protoc-gen-validate.
+type multiValidationError interface {
+	AllErrors() []error
+}
+
+// fieldValidationError is implemented by the XValidationError types generated by protoc-gen-validate.
+type fieldValidationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+type ValidationInterceptor interface {
+	UnaryServerInterceptor() grpc.UnaryServerInterceptor
+	StreamServerInterceptor() grpc.StreamServerInterceptor
+}
+
+type validationInterceptor struct {
+	logger *zap.Logger
+}
+
+func NewValidationInterceptor(
+	logger *zap.Logger,
+) ValidationInterceptor {
+	return &validationInterceptor{
+		logger: logger,
+	}
+}
+
+// validatedServerStream validates every message received from the client before handing it to the handler.
+type validatedServerStream struct {
+	grpc.ServerStream
+	validate func(ctx context.Context, message any) error
+}
+
+func (v validatedServerStream) RecvMsg(message any) error {
+	if err := v.ServerStream.RecvMsg(message); err != nil {
+		return err
+	}
+
+	return v.validate(v.Context(), message)
+}
+
+// UnaryServerInterceptor implements ValidationInterceptor.
+func (v validationInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := v.validate(ctx, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor implements ValidationInterceptor.
+func (v validationInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, validatedServerStream{ServerStream: stream, validate: v.validate})
+	}
+}
+
+// validate returns an InvalidArgument status carrying a google.rpc.BadRequest with every rule the message breaks.
+func (v validationInterceptor) validate(ctx context.Context, message any) error {
+	validator, ok := message.(validatorAll)
+	if !ok {
+		return nil
+	}
+
+	err := validator.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	fieldViolations := v.getFieldViolations("", err)
+	if len(fieldViolations) == 0 {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	validationStatus, detailsErr := status.New(codes.InvalidArgument, requestValidationFailedMessage).
+		WithDetails(&errdetails.BadRequest{FieldViolations: fieldViolations})
+	if detailsErr != nil {
+		utils.LoggerWithContext(ctx, v.logger).With(zap.Error(detailsErr)).Warn("failed to attach field violations")
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return validationStatus.Err()
+}
+
+// getFieldViolations flattens the errors of ValidateAll, following embedded messages so that every violation is
+// reported on the path of the field that broke the rule, such as "of_account.account_name".
+func (v validationInterceptor) getFieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multiErr multiValidationError
+	if errors.As(err, &multiErr) {
+		fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0)
+		for _, fieldErr := range multiErr.AllErrors() {
+			fieldViolations = append(fieldViolations, v.getFieldViolations(prefix, fieldErr)...)
+		}
+
+		return fieldViolations
+	}
+
+	var fieldErr fieldValidationError
+	if !errors.As(err, &fieldErr) {
+		return nil
+	}
+
+	field := v.toProtoFieldName(fieldErr.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+
+	if fieldErr.Cause() != nil {
+		if nestedFieldViolations := v.getFieldViolations(field, fieldErr.Cause()); len(nestedFieldViolations) > 0 {
+			return nestedFieldViolations
+		}
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{
+		{
+			Field:       field,
+			Description: fieldErr.Reason(),
+		},
+	}
+}
+
+// toProtoFieldName turns the Go field name reported by protoc-gen-validate back into the snake case name used
+// in goload.proto, which is also the name REST clients see.
+func (v validationInterceptor) toProtoFieldName(goFieldName string) string {
+	var builder strings.Builder
+	for i, r := range goFieldName {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				builder.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
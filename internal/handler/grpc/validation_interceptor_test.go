@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/generated/grpc/goload"
+)
+
+func TestValidationInterceptorUnaryServerInterceptor(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		request              any
+		expectedCode         codes.Code
+		expectedFieldList    []string
+		expectHandlerInvoked bool
+	}{
+		{
+			name:                 "update without url",
+			request:              &goload.UpdateDownloadTaskRequest{Id: 1, DownloadTaskStatus: goload.DownloadStatus_Paused},
+			expectedCode:         codes.OK,
+			expectHandlerInvoked: true,
+		},
+		{
+			name:                 "update with absolute url",
+			request:              &goload.UpdateDownloadTaskRequest{Id: 1, Url: "https://example.com/file"},
+			expectedCode:         codes.OK,
+			expectHandlerInvoked: true,
+		},
+		{
+			name:              "update with relative url",
+			request:           &goload.UpdateDownloadTaskRequest{Id: 1, Url: "example.com/file"},
+			expectedCode:      codes.InvalidArgument,
+			expectedFieldList: []string{"url"},
+		},
+		{
+			name:              "create without url",
+			request:           &goload.CreateDownloadTaskRequest{},
+			expectedCode:      codes.InvalidArgument,
+			expectedFieldList: []string{"url"},
+		},
+		{
+			name:              "every violation is reported",
+			request:           &goload.CreateAccountRequest{AccountName: "a", Password: "b"},
+			expectedCode:      codes.InvalidArgument,
+			expectedFieldList: []string{"account_name", "password"},
+		},
+		{
+			name:                 "message without rules",
+			request:              "not a message",
+			expectedCode:         codes.OK,
+			expectHandlerInvoked: true,
+		},
+	}
+
+	interceptor := NewValidationInterceptor(zap.NewNop()).UnaryServerInterceptor()
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			handlerInvoked := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				handlerInvoked = true
+				return nil, nil
+			}
+
+			_, err := interceptor(context.Background(), testCase.request, &grpc.UnaryServerInfo{}, handler)
+			if code := status.Code(err); code != testCase.expectedCode {
+				t.Fatalf("interceptor returned %v, expected code %s", err, testCase.expectedCode)
+			}
+			if handlerInvoked != testCase.expectHandlerInvoked {
+				t.Fatalf("handler invoked: %t, expected %t", handlerInvoked, testCase.expectHandlerInvoked)
+			}
+
+			fieldList := make([]string, 0)
+			for _, detail := range status.Convert(err).Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, fieldViolation := range badRequest.GetFieldViolations() {
+						fieldList = append(fieldList, fieldViolation.GetField())
+					}
+				}
+			}
+			if len(fieldList) != len(testCase.expectedFieldList) {
+				t.Fatalf("violated fields are %v, expected %v", fieldList, testCase.expectedFieldList)
+			}
+			for i := range fieldList {
+				if fieldList[i] != testCase.expectedFieldList[i] {
+					t.Fatalf("violated fields are %v, expected %v", fieldList, testCase.expectedFieldList)
+				}
+			}
+		})
+	}
+}
@@ -4,5 +4,7 @@ import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
 	NewHandler,
+	NewAuthInterceptor,
+	NewValidationInterceptor,
 	NewServer,
 )
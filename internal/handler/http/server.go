@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"net/http"
 
@@ -12,30 +13,39 @@ import (
 
 	"goload/internal/configs"
 	"goload/internal/generated/grpc/goload"
+	"goload/internal/logic"
 	"goload/internal/utils"
 )
 
+const (
+	jsonWebKeySetPath         = "/.well-known/jwks.json"
+	jsonWebKeySetCacheControl = "public, max-age=300"
+)
+
 type Server interface {
 	Start(ctx context.Context) error
 	Stop(ctx context.Context) error
 }
 
 type server struct {
-	httpConfig configs.HTTP
-	grpcConfig configs.GRPC
-	httpServer *http.Server
-	logger     *zap.Logger
+	httpConfig   configs.HTTP
+	grpcConfig   configs.GRPC
+	tokenService logic.TokenService
+	httpServer   *http.Server
+	logger       *zap.Logger
 }
 
 func NewServer(
 	httpConfig configs.HTTP,
 	grpcConfig configs.GRPC,
+	tokenService logic.TokenService,
 	logger *zap.Logger,
 ) Server {
 	return &server{
-		httpConfig: httpConfig,
-		grpcConfig: grpcConfig,
-		logger:     logger,
+		httpConfig:   httpConfig,
+		grpcConfig:   grpcConfig,
+		tokenService: tokenService,
+		logger:       logger,
 	}
 }
 
@@ -53,6 +63,10 @@ func (s *server) Start(ctx context.Context) error {
 		return err
 	}
 
+	if err := mux.HandlePath(http.MethodGet, jsonWebKeySetPath, s.handleJSONWebKeySet); err != nil {
+		return err
+	}
+
 	listener, err := net.Listen("tcp", s.httpConfig.Address)
 	if err != nil {
 		return err
@@ -65,6 +79,23 @@ func (s *server) Start(ctx context.Context) error {
 	return s.httpServer.Serve(listener)
 }
 
+// handleJSONWebKeySet serves the public keys of goload tokens, so that other services can verify them.
+func (s *server) handleJSONWebKeySet(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	logger := utils.LoggerWithContext(r.Context(), s.logger)
+
+	keySet, err := s.tokenService.GetJSONWebKeySet(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", jsonWebKeySetCacheControl)
+	if err = json.NewEncoder(w).Encode(keySet); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to write json web key set")
+	}
+}
+
 func (s *server) Stop(ctx context.Context) error {
 	if s.httpServer != nil {
 		return s.httpServer.Shutdown(ctx)
@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"goload/internal/logic"
+	"goload/internal/utils"
+)
+
+type BootstrapAdminAccounts interface {
+	Run(ctx context.Context) error
+}
+
+type bootstrapAdminAccounts struct {
+	accountService logic.AccountService
+	logger         *zap.Logger
+}
+
+func NewBootstrapAdminAccounts(
+	accountService logic.AccountService,
+	logger *zap.Logger,
+) BootstrapAdminAccounts {
+	return &bootstrapAdminAccounts{
+		accountService: accountService,
+		logger:         logger,
+	}
+}
+
+// Run implements BootstrapAdminAccounts.
+func (b bootstrapAdminAccounts) Run(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, b.logger)
+
+	if err := b.accountService.BootstrapAdminAccounts(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to bootstrap admin accounts")
+		return err
+	}
+
+	return nil
+}
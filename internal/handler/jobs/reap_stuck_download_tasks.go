@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"goload/internal/logic"
+	"goload/internal/utils"
+)
+
+type ReapStuckDownloadTasks interface {
+	Run(ctx context.Context) error
+}
+
+type reapStuckDownloadTasks struct {
+	downloadTaskService logic.DownloadTaskService
+	logger              *zap.Logger
+}
+
+func NewReapStuckDownloadTasks(
+	downloadTaskService logic.DownloadTaskService,
+	logger *zap.Logger,
+) ReapStuckDownloadTasks {
+	return &reapStuckDownloadTasks{
+		downloadTaskService: downloadTaskService,
+		logger:              logger,
+	}
+}
+
+// Run implements ReapStuckDownloadTasks.
+func (r reapStuckDownloadTasks) Run(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	if err := r.downloadTaskService.RequeueStuckDownloadTasks(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to requeue stuck download tasks")
+		return err
+	}
+
+	return nil
+}
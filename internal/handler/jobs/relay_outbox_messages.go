@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"goload/internal/logic"
+	"goload/internal/utils"
+)
+
+type RelayOutboxMessages interface {
+	Run(ctx context.Context) error
+}
+
+type relayOutboxMessages struct {
+	outboxService logic.OutboxService
+	logger        *zap.Logger
+}
+
+func NewRelayOutboxMessages(
+	outboxService logic.OutboxService,
+	logger *zap.Logger,
+) RelayOutboxMessages {
+	return &relayOutboxMessages{
+		outboxService: outboxService,
+		logger:        logger,
+	}
+}
+
+// Run implements RelayOutboxMessages.
+func (r relayOutboxMessages) Run(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	if err := r.outboxService.RelayOutboxMessages(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to relay outbox messages")
+		return err
+	}
+
+	if err := r.outboxService.DeleteExpiredOutboxMessages(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete expired outbox messages")
+		return err
+	}
+
+	return nil
+}
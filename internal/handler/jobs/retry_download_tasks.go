@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"goload/internal/logic"
+	"goload/internal/utils"
+)
+
+type RetryDownloadTasks interface {
+	Run(ctx context.Context) error
+}
+
+type retryDownloadTasks struct {
+	downloadTaskService logic.DownloadTaskService
+	logger              *zap.Logger
+}
+
+func NewRetryDownloadTasks(
+	downloadTaskService logic.DownloadTaskService,
+	logger *zap.Logger,
+) RetryDownloadTasks {
+	return &retryDownloadTasks{
+		downloadTaskService: downloadTaskService,
+		logger:              logger,
+	}
+}
+
+// Run implements RetryDownloadTasks.
+func (r retryDownloadTasks) Run(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	if err := r.downloadTaskService.EnqueueDueDownloadTaskRetries(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to enqueue download task retries")
+		return err
+	}
+
+	return nil
+}
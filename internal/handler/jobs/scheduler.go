@@ -0,0 +1,92 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"goload/internal/configs"
+)
+
+type Scheduler interface {
+	Start(ctx context.Context) error
+}
+
+type scheduler struct {
+	retryDownloadTasks     RetryDownloadTasks
+	relayOutboxMessages    RelayOutboxMessages
+	reapStuckDownloadTasks ReapStuckDownloadTasks
+	bootstrapAdminAccounts BootstrapAdminAccounts
+	retryCheckInterval     time.Duration
+	outboxRelayInterval    time.Duration
+	heartbeatCheckInterval time.Duration
+	logger                 *zap.Logger
+}
+
+func NewScheduler(
+	retryDownloadTasks RetryDownloadTasks,
+	relayOutboxMessages RelayOutboxMessages,
+	reapStuckDownloadTasks ReapStuckDownloadTasks,
+	bootstrapAdminAccounts BootstrapAdminAccounts,
+	downloadConfig configs.Download,
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Scheduler, error) {
+	retryCheckInterval, err := downloadConfig.Retry.GetCheckIntervalDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	outboxRelayInterval, err := mqConfig.GetOutboxRelayIntervalDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatCheckInterval, err := downloadConfig.Heartbeat.GetCheckIntervalDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduler{
+		retryDownloadTasks:     retryDownloadTasks,
+		relayOutboxMessages:    relayOutboxMessages,
+		reapStuckDownloadTasks: reapStuckDownloadTasks,
+		bootstrapAdminAccounts: bootstrapAdminAccounts,
+		retryCheckInterval:     retryCheckInterval,
+		outboxRelayInterval:    outboxRelayInterval,
+		heartbeatCheckInterval: heartbeatCheckInterval,
+		logger:                 logger,
+	}, nil
+}
+
+// runEvery calls run every interval until ctx is done. Errors are already logged by the jobs themselves, so the
+// next tick is simply another attempt.
+func (s scheduler) runEvery(ctx context.Context, interval time.Duration, run func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = run(ctx)
+		}
+	}
+}
+
+// Start implements Scheduler.
+func (s scheduler) Start(ctx context.Context) error {
+	// Failing to promote the admin accounts does not stop the server, it is tried again on the next start.
+	_ = s.bootstrapAdminAccounts.Run(ctx)
+
+	go s.runEvery(ctx, s.retryCheckInterval, s.retryDownloadTasks.Run)
+	go s.runEvery(ctx, s.outboxRelayInterval, s.relayOutboxMessages.Run)
+	go s.runEvery(ctx, s.heartbeatCheckInterval, s.reapStuckDownloadTasks.Run)
+
+	s.logger.Info("job scheduler started")
+	<-ctx.Done()
+
+	return nil
+}
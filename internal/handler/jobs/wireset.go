@@ -2,4 +2,10 @@ package jobs
 
 import "github.com/google/wire"
 
-var WireSet = wire.NewSet()
+var WireSet = wire.NewSet(
+	NewRetryDownloadTasks,
+	NewRelayOutboxMessages,
+	NewReapStuckDownloadTasks,
+	NewBootstrapAdminAccounts,
+	NewScheduler,
+)
@@ -5,6 +5,7 @@ import (
 
 	"goload/internal/handler/grpc"
 	"goload/internal/handler/http"
+	"goload/internal/handler/jobs"
 	"goload/internal/handler/mq"
 )
 
@@ -12,4 +13,5 @@ var WireSet = wire.NewSet(
 	grpc.WireSet,
 	http.WireSet,
 	mq.WireSet,
+	jobs.WireSet,
 )
@@ -2,17 +2,44 @@ package logic
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
 	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/notifier"
 	"goload/internal/generated/grpc/goload"
+	"goload/internal/utils"
 )
 
-var ErrAccountWrongPassword = status.Error(codes.Unauthenticated, "incorrect password")
+const (
+	opaqueTokenSizeInBytes = 32
+)
+
+var (
+	ErrAccountWrongPassword = status.Error(codes.Unauthenticated, "incorrect password")
+
+	errInvalidRefreshToken       = status.Error(codes.Unauthenticated, "invalid refresh token")
+	errRefreshTokenReused        = status.Error(codes.Unauthenticated, "refresh token was already used, the session is revoked")
+	errGenerateOpaqueTokenFailed = status.Error(codes.Internal, "failed to generate token")
+	errInvalidPasswordResetToken = status.Error(codes.Unauthenticated, "invalid password reset token")
+	errUpdatePasswordFailed      = status.Error(codes.Internal, "failed to update password")
+	errAccountDisabled           = status.Error(codes.PermissionDenied, "account is disabled")
+	errCannotManageOwnAccount    = status.Error(codes.FailedPrecondition, "admins can not disable or change the role of their own account")
+	errInvalidRole               = status.Error(codes.InvalidArgument, "role is invalid")
+)
 
 type CreateAccountInput struct {
 	AccountName string
@@ -27,40 +54,169 @@ type CreateAccountOutput struct {
 type CreateSessionInput struct {
 	AccountName string
 	Password    string
+	// ClientIP is used to throttle failed logins, it is empty if the IP is not known.
+	ClientIP string
 }
 
 type CreateSessionOutput struct {
-	Account goload.Account
-	Token   string
+	Account      goload.Account
+	Token        string
+	RefreshToken string
+}
+
+type RefreshSessionInput struct {
+	RefreshToken string
+}
+
+type RefreshSessionOutput struct {
+	Token        string
+	RefreshToken string
+}
+
+type DeleteSessionInput struct {
+	RefreshToken string
+}
+
+type DeleteSessionOutput struct {
+	Deleted bool
+}
+
+type ChangePasswordInput struct {
+	AccountID   uint64
+	OldPassword string
+	NewPassword string
+}
+
+type ChangePasswordOutput struct {
+	Changed bool
+}
+
+type RequestPasswordResetInput struct {
+	AccountName string
+}
+
+type RequestPasswordResetOutput struct{}
+
+type ResetPasswordInput struct {
+	ResetToken  string
+	NewPassword string
+}
+
+type ResetPasswordOutput struct {
+	Changed bool
+}
+
+type DisableAccountInput struct {
+	AdminAccountID uint64
+	AccountID      uint64
+}
+
+type DisableAccountOutput struct {
+	Disabled bool
+}
+
+type UpdateAccountRoleInput struct {
+	AdminAccountID uint64
+	AccountID      uint64
+	Role           goload.Role
+}
+
+type UpdateAccountRoleOutput struct {
+	Updated bool
+}
+
+type UpdateAccountMaxFileSizeInput struct {
+	AdminAccountID     uint64
+	AccountID          uint64
+	MaxFileSizeInBytes uint64
+}
+
+type UpdateAccountMaxFileSizeOutput struct {
+	Updated bool
 }
 
 type AccountService interface {
 	CreateAccount(ctx context.Context, input CreateAccountInput) (CreateAccountOutput, error)
 	CreateSession(ctx context.Context, input CreateSessionInput) (CreateSessionOutput, error)
+	// RefreshSession issues a new access token and replaces the refresh token it was called with.
+	RefreshSession(ctx context.Context, input RefreshSessionInput) (RefreshSessionOutput, error)
+	DeleteSession(ctx context.Context, input DeleteSessionInput) (DeleteSessionOutput, error)
+	// ChangePassword replaces the password of the account and logs out all of its sessions.
+	ChangePassword(ctx context.Context, input ChangePasswordInput) (ChangePasswordOutput, error)
+	// RequestPasswordReset sends a single use reset token to the owner of the account. It succeeds even if the
+	// account does not exist or is disabled, so that it can not be used to find out which account names are taken.
+	RequestPasswordReset(ctx context.Context, input RequestPasswordResetInput) (RequestPasswordResetOutput, error)
+	// ResetPassword replaces the password of the account the reset token was sent for and logs out all of its
+	// sessions.
+	ResetPassword(ctx context.Context, input ResetPasswordInput) (ResetPasswordOutput, error)
+	// DisableAccount stops the account from logging in and logs out all of its sessions.
+	DisableAccount(ctx context.Context, input DisableAccountInput) (DisableAccountOutput, error)
+	UpdateAccountRole(ctx context.Context, input UpdateAccountRoleInput) (UpdateAccountRoleOutput, error)
+	// UpdateAccountMaxFileSize gives the account its own maximum file size, zero resets it to the configured one.
+	UpdateAccountMaxFileSize(ctx context.Context, input UpdateAccountMaxFileSizeInput) (UpdateAccountMaxFileSizeOutput, error)
+	// BootstrapAdminAccounts promotes the configured admin accounts that exist to admin.
+	BootstrapAdminAccounts(ctx context.Context) error
 }
 
 type accountService struct {
-	database                  *goqu.Database
-	accountRepository         database.AccountRepository
-	accountPasswordRepository database.AccountPasswordRepository
-	hashService               HashService
-	tokenService              TokenService
+	database                     *goqu.Database
+	accountRepository            database.AccountRepository
+	accountPasswordRepository    database.AccountPasswordRepository
+	sessionRepository            database.SessionRepository
+	passwordResetTokenRepository database.PasswordResetTokenRepository
+	hashService                  HashService
+	tokenService                 TokenService
+	loginThrottle                *loginThrottle
+	notifier                     notifier.Notifier
+	refreshTokenExpiresIn        time.Duration
+	passwordResetTokenExpiresIn  time.Duration
+	adminAccountNameList         []string
+	logger                       *zap.Logger
 }
 
 func NewAccountService(
 	database *goqu.Database,
 	accountRepository database.AccountRepository,
 	accountPasswordRepository database.AccountPasswordRepository,
+	sessionRepository database.SessionRepository,
+	passwordResetTokenRepository database.PasswordResetTokenRepository,
 	hashService HashService,
 	tokenService TokenService,
-) AccountService {
-	return &accountService{
-		database:                  database,
-		accountRepository:         accountRepository,
-		accountPasswordRepository: accountPasswordRepository,
-		hashService:               hashService,
-		tokenService:              tokenService,
+	loginAttempt cache.LoginAttempt,
+	notifier notifier.Notifier,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (AccountService, error) {
+	refreshTokenExpiresIn, err := authConfig.Token.GetRefreshTokenExpiresInDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	passwordResetTokenExpiresIn, err := authConfig.PasswordReset.GetTokenExpiresInDuration()
+	if err != nil {
+		return nil, err
 	}
+
+	loginThrottle, err := newLoginThrottle(loginAttempt, authConfig.LoginThrottle, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountService{
+		database:                     database,
+		accountRepository:            accountRepository,
+		accountPasswordRepository:    accountPasswordRepository,
+		sessionRepository:            sessionRepository,
+		passwordResetTokenRepository: passwordResetTokenRepository,
+		hashService:                  hashService,
+		tokenService:                 tokenService,
+		loginThrottle:                loginThrottle,
+		notifier:                     notifier,
+		refreshTokenExpiresIn:        refreshTokenExpiresIn,
+		passwordResetTokenExpiresIn:  passwordResetTokenExpiresIn,
+		adminAccountNameList:         authConfig.AdminAccountNames,
+		logger:                       logger,
+	}, nil
 }
 
 func (a accountService) isAccountNameTaken(ctx context.Context, accountName string) (bool, error) {
@@ -123,8 +279,15 @@ func (a *accountService) CreateAccount(ctx context.Context, input CreateAccountI
 
 // CreateSession implements AccountService.
 func (a *accountService) CreateSession(ctx context.Context, input CreateSessionInput) (CreateSessionOutput, error) {
+	if err := a.loginThrottle.CheckLockout(ctx, input.AccountName, input.ClientIP); err != nil {
+		return CreateSessionOutput{}, err
+	}
+
 	foundAccount, err := a.accountRepository.GetAccountByAccountName(ctx, input.AccountName)
 	if err != nil {
+		if errors.Is(err, database.ErrAccountNotFound) {
+			return CreateSessionOutput{}, a.loginThrottle.RecordFailure(ctx, input.AccountName, input.ClientIP, err)
+		}
 		return CreateSessionOutput{}, err
 	}
 
@@ -138,23 +301,567 @@ func (a *accountService) CreateSession(ctx context.Context, input CreateSessionI
 		return CreateSessionOutput{}, err
 	}
 	if !isHashEqual {
-		return CreateSessionOutput{}, ErrAccountWrongPassword
+		return CreateSessionOutput{}, a.loginThrottle.RecordFailure(
+			ctx, input.AccountName, input.ClientIP, ErrAccountWrongPassword)
+	}
+
+	a.loginThrottle.RecordSuccess(ctx, input.AccountName)
+	if foundAccount.DisabledAt.Valid {
+		return CreateSessionOutput{}, errAccountDisabled
 	}
 
-	token, err := a.tokenService.GetToken(ctx, foundAccount.ID)
+	a.rehashPasswordIfOutdated(ctx, foundAccountPassword, input.Password)
+
+	refreshToken, refreshTokenHash, err := a.generateOpaqueToken()
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	sessionID, err := a.sessionRepository.CreateSession(ctx, database.Session{
+		OfAccountID:      foundAccount.ID,
+		RefreshTokenHash: refreshTokenHash,
+		ExpiresAt:        time.Now().Add(a.refreshTokenExpiresIn),
+	})
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	token, err := a.tokenService.GetToken(ctx, foundAccount.ID, sessionID, foundAccount.Role)
 	if err != nil {
 		return CreateSessionOutput{}, err
 	}
 
 	return CreateSessionOutput{
-		Account: *a.toProtoAccount(foundAccount),
-		Token:   token,
+		Account:      *a.toProtoAccount(foundAccount),
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// RefreshSession implements AccountService.
+func (a *accountService) RefreshSession(ctx context.Context, input RefreshSessionInput) (RefreshSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	newRefreshToken, newRefreshTokenHash, err := a.generateOpaqueToken()
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	var (
+		refreshTokenHash = a.hashOpaqueToken(input.RefreshToken)
+		session          database.Session
+		reused           = false
+	)
+	txnErr := a.database.WithTx(func(td *goqu.TxDatabase) error {
+		session, err = a.sessionRepository.WithDatabase(td).GetSessionByRefreshTokenHashWithXLock(ctx, refreshTokenHash)
+		if err != nil {
+			if errors.Is(err, database.ErrSessionNotFound) {
+				return errInvalidRefreshToken
+			}
+			return err
+		}
+
+		if session.RevokedAt.Valid || time.Now().After(session.ExpiresAt) {
+			return errInvalidRefreshToken
+		}
+
+		// A refresh token that was already replaced is only presented again if it was stolen, so the whole session
+		// is revoked, logging out both the thief and the owner.
+		if session.RefreshTokenHash != refreshTokenHash {
+			reused = true
+			session.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			return a.sessionRepository.WithDatabase(td).UpdateSession(ctx, session)
+		}
+
+		session.PreviousRefreshTokenHash = sql.NullString{String: session.RefreshTokenHash, Valid: true}
+		session.RefreshTokenHash = newRefreshTokenHash
+		session.ExpiresAt = time.Now().Add(a.refreshTokenExpiresIn)
+		return a.sessionRepository.WithDatabase(td).UpdateSession(ctx, session)
+	})
+	if txnErr != nil {
+		return RefreshSessionOutput{}, txnErr
+	}
+
+	if reused {
+		logger.With(zap.Uint64("session_id", session.ID)).Warn("refresh token is reused, revoked session")
+		if err = a.tokenService.RevokeSession(ctx, session.ID); err != nil {
+			return RefreshSessionOutput{}, err
+		}
+
+		return RefreshSessionOutput{}, errRefreshTokenReused
+	}
+
+	account, err := a.accountRepository.GetAccountByID(ctx, session.OfAccountID)
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+	if account.DisabledAt.Valid {
+		return RefreshSessionOutput{}, errAccountDisabled
+	}
+
+	token, err := a.tokenService.GetToken(ctx, account.ID, session.ID, account.Role)
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	return RefreshSessionOutput{
+		Token:        token,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+// DeleteSession implements AccountService.
+func (a *accountService) DeleteSession(ctx context.Context, input DeleteSessionInput) (DeleteSessionOutput, error) {
+	var session database.Session
+	txnErr := a.database.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		session, err = a.sessionRepository.
+			WithDatabase(td).
+			GetSessionByRefreshTokenHashWithXLock(ctx, a.hashOpaqueToken(input.RefreshToken))
+		if err != nil {
+			if errors.Is(err, database.ErrSessionNotFound) {
+				return errInvalidRefreshToken
+			}
+			return err
+		}
+
+		if session.RevokedAt.Valid {
+			return nil
+		}
+
+		session.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		return a.sessionRepository.WithDatabase(td).UpdateSession(ctx, session)
+	})
+	if txnErr != nil {
+		return DeleteSessionOutput{}, txnErr
+	}
+
+	// Revoking is idempotent, so a client that got an error here can simply log out again.
+	if err := a.tokenService.RevokeSession(ctx, session.ID); err != nil {
+		return DeleteSessionOutput{}, err
+	}
+
+	return DeleteSessionOutput{
+		Deleted: true,
+	}, nil
+}
+
+// ChangePassword implements AccountService.
+func (a *accountService) ChangePassword(ctx context.Context, input ChangePasswordInput) (ChangePasswordOutput, error) {
+	account, err := a.accountRepository.GetAccountByID(ctx, input.AccountID)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	// Guessing the old password with a stolen access token is throttled the same way as logging in.
+	if err = a.loginThrottle.CheckLockout(ctx, account.AccountName, ""); err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	accountPassword, err := a.accountPasswordRepository.GetAccountPasswordByOfAccountID(ctx, account.ID)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	isHashEqual, err := a.hashService.IsHashEqual(ctx, input.OldPassword, accountPassword.Hash)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+	if !isHashEqual {
+		return ChangePasswordOutput{}, a.loginThrottle.RecordFailure(ctx, account.AccountName, "", ErrAccountWrongPassword)
+	}
+
+	a.loginThrottle.RecordSuccess(ctx, account.AccountName)
+
+	hashedPassword, err := a.hashService.Hash(ctx, input.NewPassword)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	var sessionIDList []uint64
+	txnErr := a.database.WithTx(func(td *goqu.TxDatabase) error {
+		sessionIDList, err = a.updatePasswordAndRevokeSessionList(ctx, td, account.ID, hashedPassword)
+		return err
+	})
+	if txnErr != nil {
+		return ChangePasswordOutput{}, txnErr
+	}
+
+	if err = a.revokeSessionList(ctx, sessionIDList); err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	return ChangePasswordOutput{
+		Changed: true,
+	}, nil
+}
+
+// RequestPasswordReset implements AccountService.
+func (a *accountService) RequestPasswordReset(
+	ctx context.Context,
+	input RequestPasswordResetInput,
+) (RequestPasswordResetOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", input.AccountName))
+
+	account, err := a.accountRepository.GetAccountByAccountName(ctx, input.AccountName)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountNotFound) {
+			logger.Info("password reset is requested for an account that does not exist")
+			return RequestPasswordResetOutput{}, nil
+		}
+		return RequestPasswordResetOutput{}, err
+	}
+
+	if account.DisabledAt.Valid {
+		logger.Info("password reset is requested for a disabled account")
+		return RequestPasswordResetOutput{}, nil
+	}
+
+	resetToken, resetTokenHash, err := a.generateOpaqueToken()
+	if err != nil {
+		return RequestPasswordResetOutput{}, err
+	}
+
+	expiresAt := time.Now().Add(a.passwordResetTokenExpiresIn)
+	if _, err = a.passwordResetTokenRepository.CreatePasswordResetToken(ctx, database.PasswordResetToken{
+		OfAccountID: account.ID,
+		TokenHash:   resetTokenHash,
+		ExpiresAt:   expiresAt,
+	}); err != nil {
+		return RequestPasswordResetOutput{}, err
+	}
+
+	if err = a.notifier.Notify(ctx, notifier.Notification{
+		AccountID:   account.ID,
+		AccountName: account.AccountName,
+		Subject:     "Password reset",
+		Body: fmt.Sprintf(
+			"Use the reset token %s to set a new password, it can be used once until %s.",
+			resetToken, expiresAt.Format(time.RFC3339)),
+	}); err != nil {
+		return RequestPasswordResetOutput{}, err
+	}
+
+	logger.Info("sent password reset token")
+	return RequestPasswordResetOutput{}, nil
+}
+
+// ResetPassword implements AccountService.
+func (a *accountService) ResetPassword(ctx context.Context, input ResetPasswordInput) (ResetPasswordOutput, error) {
+	hashedPassword, err := a.hashService.Hash(ctx, input.NewPassword)
+	if err != nil {
+		return ResetPasswordOutput{}, err
+	}
+
+	var sessionIDList []uint64
+	txnErr := a.database.WithTx(func(td *goqu.TxDatabase) error {
+		passwordResetToken, err := a.passwordResetTokenRepository.
+			WithDatabase(td).
+			GetPasswordResetTokenByTokenHashWithXLock(ctx, a.hashOpaqueToken(input.ResetToken))
+		if err != nil {
+			if errors.Is(err, database.ErrPasswordResetTokenNotFound) {
+				return errInvalidPasswordResetToken
+			}
+			return err
+		}
+
+		if passwordResetToken.UsedAt.Valid || time.Now().After(passwordResetToken.ExpiresAt) {
+			return errInvalidPasswordResetToken
+		}
+
+		// The token may have been sent before the account was disabled.
+		account, err := a.accountRepository.WithDatabase(td).GetAccountByIDWithXLock(ctx, passwordResetToken.OfAccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.DisabledAt.Valid {
+			return errAccountDisabled
+		}
+
+		passwordResetToken.UsedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		if err = a.passwordResetTokenRepository.
+			WithDatabase(td).
+			UpdatePasswordResetToken(ctx, passwordResetToken); err != nil {
+			return err
+		}
+
+		sessionIDList, err = a.updatePasswordAndRevokeSessionList(
+			ctx, td, passwordResetToken.OfAccountID, hashedPassword)
+		return err
+	})
+	if txnErr != nil {
+		return ResetPasswordOutput{}, txnErr
+	}
+
+	if err = a.revokeSessionList(ctx, sessionIDList); err != nil {
+		return ResetPasswordOutput{}, err
+	}
+
+	return ResetPasswordOutput{
+		Changed: true,
+	}, nil
+}
+
+// DisableAccount implements AccountService.
+func (a *accountService) DisableAccount(ctx context.Context, input DisableAccountInput) (DisableAccountOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("admin_account_id", input.AdminAccountID)).
+		With(zap.Uint64("account_id", input.AccountID))
+
+	if err := a.checkAdminPermission(ctx, input.AdminAccountID, input.AccountID); err != nil {
+		return DisableAccountOutput{}, err
+	}
+
+	var sessionIDList []uint64
+	txnErr := a.database.WithTx(func(td *goqu.TxDatabase) error {
+		account, err := a.accountRepository.WithDatabase(td).GetAccountByIDWithXLock(ctx, input.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.DisabledAt.Valid {
+			return nil
+		}
+
+		account.DisabledAt = sql.NullTime{Time: time.Now(), Valid: true}
+		if err = a.accountRepository.WithDatabase(td).UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		sessionIDList, err = a.sessionRepository.WithDatabase(td).RevokeSessionListOfAccount(ctx, account.ID, time.Now())
+		return err
+	})
+	if txnErr != nil {
+		return DisableAccountOutput{}, txnErr
+	}
+
+	if err := a.revokeSessionList(ctx, sessionIDList); err != nil {
+		return DisableAccountOutput{}, err
+	}
+
+	logger.Info("account is disabled")
+	return DisableAccountOutput{
+		Disabled: true,
+	}, nil
+}
+
+// UpdateAccountRole implements AccountService.
+func (a *accountService) UpdateAccountRole(ctx context.Context, input UpdateAccountRoleInput) (UpdateAccountRoleOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("admin_account_id", input.AdminAccountID)).
+		With(zap.Uint64("account_id", input.AccountID)).
+		With(zap.String("role", input.Role.String()))
+
+	if _, ok := goload.Role_name[int32(input.Role)]; !ok || input.Role == goload.Role_UndefinedRole {
+		return UpdateAccountRoleOutput{}, errInvalidRole
+	}
+
+	if err := a.checkAdminPermission(ctx, input.AdminAccountID, input.AccountID); err != nil {
+		return UpdateAccountRoleOutput{}, err
+	}
+
+	txnErr := a.database.WithTx(func(td *goqu.TxDatabase) error {
+		account, err := a.accountRepository.WithDatabase(td).GetAccountByIDWithXLock(ctx, input.AccountID)
+		if err != nil {
+			return err
+		}
+
+		account.Role = input.Role
+		return a.accountRepository.WithDatabase(td).UpdateAccount(ctx, account)
+	})
+	if txnErr != nil {
+		return UpdateAccountRoleOutput{}, txnErr
+	}
+
+	// Access tokens issued before keep the previous role until they expire, but permissions are always checked
+	// against the stored role as well.
+	logger.Info("account role is updated")
+	return UpdateAccountRoleOutput{
+		Updated: true,
+	}, nil
+}
+
+// UpdateAccountMaxFileSize implements AccountService.
+func (a *accountService) UpdateAccountMaxFileSize(
+	ctx context.Context,
+	input UpdateAccountMaxFileSizeInput,
+) (UpdateAccountMaxFileSizeOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("admin_account_id", input.AdminAccountID)).
+		With(zap.Uint64("account_id", input.AccountID)).
+		With(zap.Uint64("max_file_size_in_bytes", input.MaxFileSizeInBytes))
+
+	if err := a.checkAdminPermission(ctx, input.AdminAccountID, input.AccountID); err != nil {
+		return UpdateAccountMaxFileSizeOutput{}, err
+	}
+
+	txnErr := a.database.WithTx(func(td *goqu.TxDatabase) error {
+		account, err := a.accountRepository.WithDatabase(td).GetAccountByIDWithXLock(ctx, input.AccountID)
+		if err != nil {
+			return err
+		}
+
+		account.MaxFileSizeInBytes = int64(input.MaxFileSizeInBytes)
+		return a.accountRepository.WithDatabase(td).UpdateAccount(ctx, account)
+	})
+	if txnErr != nil {
+		return UpdateAccountMaxFileSizeOutput{}, txnErr
+	}
+
+	// Download tasks that are already downloading keep the limit they started with.
+	logger.Info("account max file size is updated")
+	return UpdateAccountMaxFileSizeOutput{
+		Updated: true,
 	}, nil
 }
 
+// BootstrapAdminAccounts implements AccountService.
+func (a *accountService) BootstrapAdminAccounts(ctx context.Context) error {
+	for _, accountName := range a.adminAccountNameList {
+		logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", accountName))
+
+		account, err := a.accountRepository.GetAccountByAccountName(ctx, accountName)
+		if err != nil {
+			if errors.Is(err, database.ErrAccountNotFound) {
+				logger.Warn("configured admin account does not exist, restart the server once it is created")
+				continue
+			}
+
+			logger.With(zap.Error(err)).Error("failed to get configured admin account")
+			return err
+		}
+
+		if account.Role == goload.Role_Admin {
+			continue
+		}
+
+		txnErr := a.database.WithTx(func(td *goqu.TxDatabase) error {
+			account, err := a.accountRepository.WithDatabase(td).GetAccountByIDWithXLock(ctx, account.ID)
+			if err != nil {
+				return err
+			}
+
+			account.Role = goload.Role_Admin
+			return a.accountRepository.WithDatabase(td).UpdateAccount(ctx, account)
+		})
+		if txnErr != nil {
+			logger.With(zap.Error(txnErr)).Error("failed to promote configured admin account")
+			return txnErr
+		}
+
+		logger.Info("configured admin account is promoted to admin")
+	}
+
+	return nil
+}
+
+// checkAdminPermission makes sure that the admin may manage accounts, and is not about to lock themselves out.
+func (a accountService) checkAdminPermission(ctx context.Context, adminAccountID uint64, accountID uint64) error {
+	adminAccount, err := a.accountRepository.GetAccountByID(ctx, adminAccountID)
+	if err != nil {
+		return err
+	}
+
+	if err = checkPermission(adminAccount, PermissionManageAccounts); err != nil {
+		return err
+	}
+
+	if adminAccount.ID == accountID {
+		return errCannotManageOwnAccount
+	}
+
+	return nil
+}
+
+// rehashPasswordIfOutdated hashes the password again if its hash was made with an algorithm or parameters that are
+// no longer configured. Logging in does not depend on it, so failures are only logged.
+func (a accountService) rehashPasswordIfOutdated(
+	ctx context.Context,
+	accountPassword database.AccountPassword,
+	password string,
+) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountPassword.OfAccountID))
+
+	if !a.hashService.NeedsRehash(ctx, accountPassword.Hash) {
+		return
+	}
+
+	hashedPassword, err := a.hashService.Hash(ctx, password)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to rehash account password")
+		return
+	}
+
+	replaced, err := a.accountPasswordRepository.ReplaceAccountPasswordHash(ctx, database.AccountPassword{
+		OfAccountID: accountPassword.OfAccountID,
+		Hash:        hashedPassword,
+	}, accountPassword.Hash)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to update rehashed account password")
+		return
+	}
+
+	if replaced {
+		logger.Info("rehashed account password")
+	}
+}
+
+// updatePasswordAndRevokeSessionList sets the password hash of the account and revokes its sessions in the
+// database, returning the ids of the revoked sessions.
+func (a accountService) updatePasswordAndRevokeSessionList(
+	ctx context.Context,
+	td *goqu.TxDatabase,
+	accountID uint64,
+	hashedPassword string,
+) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	if err := a.accountPasswordRepository.WithDatabase(td).UpdateAccountPassword(ctx, database.AccountPassword{
+		OfAccountID: accountID,
+		Hash:        hashedPassword,
+	}); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account password")
+		return nil, errUpdatePasswordFailed
+	}
+
+	return a.sessionRepository.WithDatabase(td).RevokeSessionListOfAccount(ctx, accountID, time.Now())
+}
+
+// revokeSessionList rejects the access tokens of sessions that were already revoked in the database.
+func (a accountService) revokeSessionList(ctx context.Context, sessionIDList []uint64) error {
+	for _, sessionID := range sessionIDList {
+		if err := a.tokenService.RevokeSession(ctx, sessionID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateOpaqueToken returns a new random token, such as a refresh token, along with the hash that is stored in
+// its place.
+func (a accountService) generateOpaqueToken() (string, string, error) {
+	tokenBytes := make([]byte, opaqueTokenSizeInBytes)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", "", errGenerateOpaqueTokenFailed
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+	return token, a.hashOpaqueToken(token), nil
+}
+
+// hashOpaqueToken does not need a slow password hash, since opaque tokens are long random strings.
+func (a accountService) hashOpaqueToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
 func (a accountService) toProtoAccount(account database.Account) *goload.Account {
 	return &goload.Account{
 		Id:          account.ID,
 		AccountName: account.AccountName,
+		Role:        account.Role,
+		Disabled:    account.DisabledAt.Valid,
 	}
 }
@@ -0,0 +1,293 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"goload/internal/dataaccess/database"
+	"goload/internal/generated/grpc/goload"
+	"goload/internal/utils"
+)
+
+const (
+	// Api keys look like goload_<id>_<secret>. The ID finds the stored hash, since a bcrypt hash can not be looked up.
+	apiKeyPrefix            = "goload_"
+	apiKeySecretSizeInBytes = 32
+)
+
+var (
+	errNotAllowToRevokeApiKey = status.Error(codes.PermissionDenied, "only owners can revoke their api keys")
+	errInvalidApiKey          = status.Error(codes.Unauthenticated, "invalid api key")
+	errApiKeyExpired          = status.Error(codes.Unauthenticated, "api key is expired")
+	errApiKeyRevoked          = status.Error(codes.Unauthenticated, "api key is revoked")
+	errInvalidApiKeyScope     = status.Error(codes.InvalidArgument, "api key scope is invalid")
+	errApiKeyExpiresAtInPast  = status.Error(codes.InvalidArgument, "api key expiry must be in the future")
+	errGenerateApiKeyFailed   = status.Error(codes.Internal, "failed to generate api key")
+)
+
+type CreateApiKeyInput struct {
+	OfAccountID uint64
+	Name        string
+	Scopes      []goload.ApiKeyScope
+	ExpiresAt   *time.Time
+}
+
+type CreateApiKeyOutput struct {
+	ApiKey *goload.ApiKey
+	Key    string
+}
+
+type ListApiKeysInput struct {
+	OfAccountID uint64
+}
+
+type ListApiKeysOutput struct {
+	ApiKeyList []*goload.ApiKey
+}
+
+type RevokeApiKeyInput struct {
+	OfAccountID uint64
+	ApiKeyID    uint64
+}
+
+type RevokeApiKeyOutput struct {
+	Revoked bool
+}
+
+type AuthenticateApiKeyOutput struct {
+	AccountID uint64
+	Scopes    []goload.ApiKeyScope
+}
+
+type ApiKeyService interface {
+	CreateApiKey(ctx context.Context, input CreateApiKeyInput) (CreateApiKeyOutput, error)
+	ListApiKeys(ctx context.Context, input ListApiKeysInput) (ListApiKeysOutput, error)
+	RevokeApiKey(ctx context.Context, input RevokeApiKeyInput) (RevokeApiKeyOutput, error)
+	// IsApiKey reports whether a credential is an api key rather than an access token.
+	IsApiKey(credential string) bool
+	AuthenticateApiKey(ctx context.Context, key string) (AuthenticateApiKeyOutput, error)
+}
+
+type apiKeyService struct {
+	accountRepository database.AccountRepository
+	apiKeyRepository  database.ApiKeyRepository
+	hashService       HashService
+	logger            *zap.Logger
+}
+
+func NewApiKeyService(
+	accountRepository database.AccountRepository,
+	apiKeyRepository database.ApiKeyRepository,
+	hashService HashService,
+	logger *zap.Logger,
+) ApiKeyService {
+	return &apiKeyService{
+		accountRepository: accountRepository,
+		apiKeyRepository:  apiKeyRepository,
+		hashService:       hashService,
+		logger:            logger,
+	}
+}
+
+// CreateApiKey implements ApiKeyService.
+func (a *apiKeyService) CreateApiKey(ctx context.Context, input CreateApiKeyInput) (CreateApiKeyOutput, error) {
+	account, err := a.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return CreateApiKeyOutput{}, err
+	}
+
+	scopes := lo.Uniq(input.Scopes)
+	for _, scope := range scopes {
+		if _, ok := goload.ApiKeyScope_name[int32(scope)]; !ok || scope == goload.ApiKeyScope_UndefinedApiKeyScope {
+			return CreateApiKeyOutput{}, errInvalidApiKeyScope
+		}
+	}
+
+	apiKey := database.ApiKey{
+		OfAccountID: account.ID,
+		Name:        input.Name,
+		Scopes:      a.encodeScopes(scopes),
+	}
+	if input.ExpiresAt != nil {
+		if !input.ExpiresAt.After(time.Now()) {
+			return CreateApiKeyOutput{}, errApiKeyExpiresAtInPast
+		}
+		apiKey.ExpiresAt = sql.NullTime{Time: *input.ExpiresAt, Valid: true}
+	}
+
+	secretBytes := make([]byte, apiKeySecretSizeInBytes)
+	if _, err = rand.Read(secretBytes); err != nil {
+		return CreateApiKeyOutput{}, errGenerateApiKeyFailed
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	apiKey.Hash, err = a.hashService.Hash(ctx, secret)
+	if err != nil {
+		return CreateApiKeyOutput{}, err
+	}
+
+	apiKey.ID, apiKey.CreatedAt, err = a.apiKeyRepository.CreateApiKey(ctx, apiKey)
+	if err != nil {
+		return CreateApiKeyOutput{}, err
+	}
+
+	return CreateApiKeyOutput{
+		ApiKey: a.toProtoApiKey(ctx, apiKey),
+		Key:    fmt.Sprintf("%s%d_%s", apiKeyPrefix, apiKey.ID, secret),
+	}, nil
+}
+
+// ListApiKeys implements ApiKeyService.
+func (a *apiKeyService) ListApiKeys(ctx context.Context, input ListApiKeysInput) (ListApiKeysOutput, error) {
+	account, err := a.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return ListApiKeysOutput{}, err
+	}
+
+	apiKeyList, err := a.apiKeyRepository.GetApiKeyListByOfAccountID(ctx, account.ID)
+	if err != nil {
+		return ListApiKeysOutput{}, err
+	}
+
+	return ListApiKeysOutput{
+		ApiKeyList: lo.Map(apiKeyList, func(item database.ApiKey, _ int) *goload.ApiKey {
+			return a.toProtoApiKey(ctx, item)
+		}),
+	}, nil
+}
+
+// RevokeApiKey implements ApiKeyService.
+func (a *apiKeyService) RevokeApiKey(ctx context.Context, input RevokeApiKeyInput) (RevokeApiKeyOutput, error) {
+	account, err := a.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return RevokeApiKeyOutput{}, err
+	}
+
+	apiKey, err := a.apiKeyRepository.GetApiKeyByID(ctx, input.ApiKeyID)
+	if err != nil {
+		return RevokeApiKeyOutput{}, err
+	}
+
+	if account.ID != apiKey.OfAccountID {
+		return RevokeApiKeyOutput{}, errNotAllowToRevokeApiKey
+	}
+
+	if !apiKey.RevokedAt.Valid {
+		apiKey.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		if err = a.apiKeyRepository.UpdateApiKey(ctx, apiKey); err != nil {
+			return RevokeApiKeyOutput{}, err
+		}
+	}
+
+	return RevokeApiKeyOutput{
+		Revoked: true,
+	}, nil
+}
+
+// IsApiKey implements ApiKeyService.
+func (a *apiKeyService) IsApiKey(credential string) bool {
+	return strings.HasPrefix(credential, apiKeyPrefix)
+}
+
+// AuthenticateApiKey implements ApiKeyService.
+func (a *apiKeyService) AuthenticateApiKey(ctx context.Context, key string) (AuthenticateApiKeyOutput, error) {
+	idString, secret, ok := strings.Cut(strings.TrimPrefix(key, apiKeyPrefix), "_")
+	if !ok {
+		return AuthenticateApiKeyOutput{}, errInvalidApiKey
+	}
+
+	id, err := strconv.ParseUint(idString, 10, 64)
+	if err != nil {
+		return AuthenticateApiKeyOutput{}, errInvalidApiKey
+	}
+
+	apiKey, err := a.apiKeyRepository.GetApiKeyByID(ctx, id)
+	if err != nil {
+		return AuthenticateApiKeyOutput{}, errInvalidApiKey
+	}
+
+	isHashEqual, err := a.hashService.IsHashEqual(ctx, secret, apiKey.Hash)
+	if err != nil {
+		return AuthenticateApiKeyOutput{}, err
+	}
+	if !isHashEqual {
+		return AuthenticateApiKeyOutput{}, errInvalidApiKey
+	}
+
+	if apiKey.RevokedAt.Valid {
+		return AuthenticateApiKeyOutput{}, errApiKeyRevoked
+	}
+
+	if apiKey.ExpiresAt.Valid && time.Now().After(apiKey.ExpiresAt.Time) {
+		return AuthenticateApiKeyOutput{}, errApiKeyExpired
+	}
+
+	account, err := a.accountRepository.GetAccountByID(ctx, apiKey.OfAccountID)
+	if err != nil {
+		return AuthenticateApiKeyOutput{}, err
+	}
+	if account.DisabledAt.Valid {
+		return AuthenticateApiKeyOutput{}, errAccountDisabled
+	}
+
+	return AuthenticateApiKeyOutput{
+		AccountID: apiKey.OfAccountID,
+		Scopes:    a.decodeScopes(ctx, apiKey),
+	}, nil
+}
+
+func (a apiKeyService) encodeScopes(scopes []goload.ApiKeyScope) string {
+	encodedScopes, _ := json.Marshal(lo.Map(scopes, func(item goload.ApiKeyScope, _ int) string {
+		return item.String()
+	}))
+
+	return string(encodedScopes)
+}
+
+// decodeScopes ignores the scopes it does not know, so that a key never gets more than it was granted.
+func (a apiKeyService) decodeScopes(ctx context.Context, apiKey database.ApiKey) []goload.ApiKeyScope {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", apiKey.ID))
+
+	scopeNames := make([]string, 0)
+	if err := json.Unmarshal([]byte(apiKey.Scopes), &scopeNames); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to parse api key scopes, ignoring them")
+		return nil
+	}
+
+	scopes := make([]goload.ApiKeyScope, 0, len(scopeNames))
+	for _, scopeName := range scopeNames {
+		if scope, ok := goload.ApiKeyScope_value[scopeName]; ok {
+			scopes = append(scopes, goload.ApiKeyScope(scope))
+		}
+	}
+
+	return scopes
+}
+
+func (a apiKeyService) toProtoApiKey(ctx context.Context, apiKey database.ApiKey) *goload.ApiKey {
+	protoApiKey := &goload.ApiKey{
+		Id:        apiKey.ID,
+		Name:      apiKey.Name,
+		Scopes:    a.decodeScopes(ctx, apiKey),
+		CreatedAt: timestamppb.New(apiKey.CreatedAt),
+		Revoked:   apiKey.RevokedAt.Valid,
+	}
+	if apiKey.ExpiresAt.Valid {
+		protoApiKey.ExpiresAt = timestamppb.New(apiKey.ExpiresAt.Time)
+	}
+
+	return protoApiKey
+}
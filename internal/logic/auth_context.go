@@ -0,0 +1,16 @@
+package logic
+
+import "context"
+
+type accountIDContextKey struct{}
+
+// ContextWithAccountID returns a copy of ctx that carries the ID of the authenticated caller.
+func ContextWithAccountID(ctx context.Context, accountID uint64) context.Context {
+	return context.WithValue(ctx, accountIDContextKey{}, accountID)
+}
+
+// AccountIDFromContext returns the ID of the authenticated caller, if the request was authenticated.
+func AccountIDFromContext(ctx context.Context) (uint64, bool) {
+	accountID, ok := ctx.Value(accountIDContextKey{}).(uint64)
+	return accountID, ok
+}
@@ -0,0 +1,180 @@
+package logic
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/generated/grpc/goload"
+)
+
+const (
+	downloadTaskMetadataFieldNameChecksums        = "checksums"
+	downloadTaskMetadataFieldNameExpectedChecksum = "expected-checksum"
+	downloadTaskMetadataFieldNameFileSize         = "file-size"
+
+	checksumFieldNameAlgorithm = "algorithm"
+	checksumFieldNameDigest    = "digest"
+)
+
+var (
+	errInvalidExpectedChecksum  = status.Error(codes.InvalidArgument, "expected checksum is invalid")
+	ErrDownloadChecksumMismatch = status.Error(codes.DataLoss, "downloaded file does not match the expected checksum")
+)
+
+// checksumAlgorithmList is the order in which checksums are returned.
+var checksumAlgorithmList = []goload.ChecksumAlgorithm{
+	goload.ChecksumAlgorithm_MD5,
+	goload.ChecksumAlgorithm_SHA1,
+	goload.ChecksumAlgorithm_SHA256,
+}
+
+var checksumAlgorithmNewHashFuncMap = map[goload.ChecksumAlgorithm]func() hash.Hash{
+	goload.ChecksumAlgorithm_MD5:    md5.New,
+	goload.ChecksumAlgorithm_SHA1:   sha1.New,
+	goload.ChecksumAlgorithm_SHA256: sha256.New,
+}
+
+// validateExpectedChecksum accepts a nil checksum, which means the file is not verified.
+func validateExpectedChecksum(checksum *goload.Checksum) error {
+	if checksum == nil {
+		return nil
+	}
+
+	newHashFunc, ok := checksumAlgorithmNewHashFuncMap[checksum.GetAlgorithm()]
+	if !ok {
+		return errInvalidExpectedChecksum
+	}
+
+	digest, err := hex.DecodeString(checksum.GetDigest())
+	if err != nil || len(digest) != newHashFunc().Size() {
+		return errInvalidExpectedChecksum
+	}
+
+	return nil
+}
+
+func setExpectedChecksumToMetadata(metadata map[string]any, checksum *goload.Checksum) {
+	metadata[downloadTaskMetadataFieldNameExpectedChecksum] = map[string]any{
+		checksumFieldNameAlgorithm: checksum.GetAlgorithm().String(),
+		checksumFieldNameDigest:    strings.ToLower(checksum.GetDigest()),
+	}
+}
+
+func getExpectedChecksumFromMetadata(metadata map[string]any) (*goload.Checksum, bool) {
+	expectedChecksum, ok := metadata[downloadTaskMetadataFieldNameExpectedChecksum].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	algorithm, _ := expectedChecksum[checksumFieldNameAlgorithm].(string)
+	digest, _ := expectedChecksum[checksumFieldNameDigest].(string)
+	algorithmValue, ok := goload.ChecksumAlgorithm_value[algorithm]
+	if !ok || digest == "" {
+		return nil, false
+	}
+
+	return &goload.Checksum{
+		Algorithm: goload.ChecksumAlgorithm(algorithmValue),
+		Digest:    digest,
+	}, true
+}
+
+// getChecksumListFromMetadata returns the checksums computed when the file was downloaded.
+func getChecksumListFromMetadata(metadata map[string]any) []*goload.Checksum {
+	checksums, ok := metadata[downloadTaskMetadataFieldNameChecksums].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	checksumList := make([]*goload.Checksum, 0, len(checksumAlgorithmList))
+	for _, algorithm := range checksumAlgorithmList {
+		if digest, ok := checksums[algorithm.String()].(string); ok {
+			checksumList = append(checksumList, &goload.Checksum{
+				Algorithm: algorithm,
+				Digest:    digest,
+			})
+		}
+	}
+
+	return checksumList
+}
+
+// fileDigester computes every supported checksum of a file, and its size, from the bytes written to it.
+type fileDigester struct {
+	hashMap map[goload.ChecksumAlgorithm]hash.Hash
+	size    int64
+}
+
+func newFileDigester() *fileDigester {
+	f := &fileDigester{}
+	f.reset()
+	return f
+}
+
+func (f *fileDigester) reset() {
+	f.hashMap = make(map[goload.ChecksumAlgorithm]hash.Hash, len(checksumAlgorithmNewHashFuncMap))
+	for algorithm, newHashFunc := range checksumAlgorithmNewHashFuncMap {
+		f.hashMap[algorithm] = newHashFunc()
+	}
+	f.size = 0
+}
+
+// Write implements io.Writer, it never fails.
+func (f *fileDigester) Write(p []byte) (int, error) {
+	for _, h := range f.hashMap {
+		h.Write(p)
+	}
+	f.size += int64(len(p))
+
+	return len(p), nil
+}
+
+func (f *fileDigester) getDigest(algorithm goload.ChecksumAlgorithm) string {
+	return hex.EncodeToString(f.hashMap[algorithm].Sum(nil))
+}
+
+// setToMetadata stores the checksums and the size of the file into metadata.
+func (f *fileDigester) setToMetadata(metadata map[string]any) {
+	checksums := make(map[string]any, len(f.hashMap))
+	for algorithm := range f.hashMap {
+		checksums[algorithm.String()] = f.getDigest(algorithm)
+	}
+
+	metadata[downloadTaskMetadataFieldNameChecksums] = checksums
+	metadata[downloadTaskMetadataFieldNameFileSize] = f.size
+}
+
+func (f *fileDigester) verify(expectedChecksum *goload.Checksum) error {
+	digest := f.getDigest(expectedChecksum.GetAlgorithm())
+	if !strings.EqualFold(digest, expectedChecksum.GetDigest()) {
+		return DownloadError{
+			Err: fmt.Errorf("%w: %s is %s, expected %s", ErrDownloadChecksumMismatch,
+				expectedChecksum.GetAlgorithm(), digest, expectedChecksum.GetDigest()),
+		}
+	}
+
+	return nil
+}
+
+// digestWriter feeds the bytes that are successfully written into writer to digester, so that a download that is
+// resumed after a failed write is still hashed correctly.
+type digestWriter struct {
+	writer   io.Writer
+	digester *fileDigester
+}
+
+func (d digestWriter) Write(p []byte) (int, error) {
+	writtenByteCount, err := d.writer.Write(p)
+	d.digester.Write(p[:writtenByteCount])
+
+	return writtenByteCount, err
+}
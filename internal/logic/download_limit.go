@@ -0,0 +1,165 @@
+package logic
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// http.DetectContentType considers at most this many bytes.
+	contentTypeSniffSizeInBytes = 512
+	contentTypeUnknown          = "application/octet-stream"
+)
+
+var (
+	ErrDownloadFileTooLarge          = status.Error(codes.FailedPrecondition, "downloaded file is too large")
+	ErrDownloadContentTypeNotAllowed = status.Error(codes.FailedPrecondition, "downloaded file type is not allowed")
+)
+
+// DownloadLimit restricts what a downloader may write. A zero MaxFileSizeInBytes means no limit, an empty
+// AllowedContentTypes allows every type that is not denied.
+type DownloadLimit struct {
+	MaxFileSizeInBytes  int64
+	AllowedContentTypes []string
+	DeniedContentTypes  []string
+}
+
+// isDownloadLimitError reports whether err is a violation of a DownloadLimit, which downloading again can not fix.
+func isDownloadLimitError(err error) bool {
+	return errors.Is(err, ErrDownloadFileTooLarge) || errors.Is(err, ErrDownloadContentTypeNotAllowed)
+}
+
+func (d DownloadLimit) checkFileSize(fileSize int64) error {
+	if d.MaxFileSizeInBytes <= 0 || fileSize <= d.MaxFileSizeInBytes {
+		return nil
+	}
+
+	return DownloadError{
+		Err: fmt.Errorf("%w: it has at least %d bytes, the limit is %d bytes", ErrDownloadFileTooLarge, fileSize, d.MaxFileSizeInBytes),
+	}
+}
+
+// checkContentType checks the type announced in the Content-Type header, or the one sniffed from the content of the
+// file. A sniffed type that is not specific is only checked against the denied types.
+func (d DownloadLimit) checkContentType(contentType string, sniffed bool) error {
+	if contentType == "" {
+		return nil
+	}
+
+	mediaType := d.getMediaType(contentType)
+	if d.matchesContentType(mediaType, d.DeniedContentTypes) {
+		return d.newContentTypeError(mediaType, sniffed)
+	}
+
+	if len(d.AllowedContentTypes) == 0 || (sniffed && mediaType == contentTypeUnknown) {
+		return nil
+	}
+
+	if !d.matchesContentType(mediaType, d.AllowedContentTypes) {
+		return d.newContentTypeError(mediaType, sniffed)
+	}
+
+	return nil
+}
+
+func (d DownloadLimit) getMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+
+	return mediaType
+}
+
+// matchesContentType tells if mediaType is one of contentTypeList, which can have wildcards such as "image/*".
+func (d DownloadLimit) matchesContentType(mediaType string, contentTypeList []string) bool {
+	for _, contentType := range contentTypeList {
+		contentType = strings.ToLower(strings.TrimSpace(contentType))
+		if contentType == mediaType || contentType == "*/*" {
+			return true
+		}
+
+		if prefix, ok := strings.CutSuffix(contentType, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d DownloadLimit) newContentTypeError(mediaType string, sniffed bool) error {
+	source := "declared"
+	if sniffed {
+		source = "detected"
+	}
+
+	return DownloadError{
+		Err: fmt.Errorf("%w: %s type is %s", ErrDownloadContentTypeNotAllowed, source, mediaType),
+	}
+}
+
+// checkResponse checks what the headers of response tell about the file, before its body is read. offset is where
+// the body starts in the file.
+func (d DownloadLimit) checkResponse(response *http.Response, offset int64) error {
+	if response.ContentLength >= 0 {
+		if err := d.checkFileSize(offset + response.ContentLength); err != nil {
+			return err
+		}
+	}
+
+	return d.checkContentType(response.Header.Get(HTTPResponseHeaderContentType), false)
+}
+
+// newLimitedBody returns a reader over body that fails once the file grows over the maximum size. If body starts
+// at the beginning of the file, the type of the file is sniffed and checked first.
+func (d DownloadLimit) newLimitedBody(body io.Reader, offset int64) (io.Reader, error) {
+	if offset == 0 {
+		bufferedBody := bufio.NewReaderSize(body, contentTypeSniffSizeInBytes)
+		head, err := bufferedBody.Peek(contentTypeSniffSizeInBytes)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+			return nil, err
+		}
+
+		if err = d.checkContentType(http.DetectContentType(head), true); err != nil {
+			return nil, err
+		}
+
+		body = bufferedBody
+	}
+
+	return &fileSizeLimitReader{
+		reader:    body,
+		limit:     d,
+		readBytes: offset,
+	}, nil
+}
+
+// fileSizeLimitReader counts the bytes of a file as they are read, and fails at the first byte over the limit.
+type fileSizeLimitReader struct {
+	reader    io.Reader
+	limit     DownloadLimit
+	readBytes int64
+}
+
+func (f *fileSizeLimitReader) Read(p []byte) (int, error) {
+	if maxFileSizeInBytes := f.limit.MaxFileSizeInBytes; maxFileSizeInBytes > 0 {
+		// Reading a single byte past the limit is enough to tell that the file is over it.
+		p = p[:min(int64(len(p)), max(maxFileSizeInBytes-f.readBytes+1, 0))]
+	}
+
+	readByteCount, err := f.reader.Read(p)
+	f.readBytes += int64(readByteCount)
+	if limitErr := f.limit.checkFileSize(f.readBytes); limitErr != nil {
+		return readByteCount, limitErr
+	}
+
+	return readByteCount, err
+}
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	downloadProgressReportInterval = time.Second
+)
+
+// DownloadProgress is a snapshot of how far a download has got. TotalBytes is zero when the size of the resource
+// is not known, ETA is zero when it can not be estimated.
+type DownloadProgress struct {
+	DownloadedBytes int64
+	TotalBytes      int64
+	BytesPerSecond  int64
+	ETA             time.Duration
+}
+
+// DownloadProgressFunc is called about once every second while downloading.
+type DownloadProgressFunc func(ctx context.Context, progress DownloadProgress)
+
+// downloadProgressReporter counts downloaded bytes, possibly from several goroutines, and reports the progress at
+// most once per downloadProgressReportInterval.
+type downloadProgressReporter struct {
+	mutex           sync.Mutex
+	downloadedBytes int64
+	totalBytes      int64
+	startedBytes    int64
+	startedAt       time.Time
+	lastReportedAt  time.Time
+	onProgress      DownloadProgressFunc
+}
+
+func newDownloadProgressReporter(downloadedBytes int64, onProgress DownloadProgressFunc) *downloadProgressReporter {
+	now := time.Now()
+	return &downloadProgressReporter{
+		downloadedBytes: downloadedBytes,
+		startedBytes:    downloadedBytes,
+		startedAt:       now,
+		lastReportedAt:  now,
+		onProgress:      onProgress,
+	}
+}
+
+func (d *downloadProgressReporter) SetTotalBytes(totalBytes int64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.totalBytes = totalBytes
+}
+
+func (d *downloadProgressReporter) Add(ctx context.Context, byteCount int64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.downloadedBytes += byteCount
+	if time.Since(d.lastReportedAt) >= downloadProgressReportInterval {
+		d.report(ctx)
+	}
+}
+
+// Report reports the progress right away, for example once the download is finished.
+func (d *downloadProgressReporter) Report(ctx context.Context) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.report(ctx)
+}
+
+func (d *downloadProgressReporter) report(ctx context.Context) {
+	d.lastReportedAt = time.Now()
+	if d.onProgress == nil {
+		return
+	}
+
+	// Throughput only counts the bytes of this execution, a resumed download did not get its head start for free.
+	progress := DownloadProgress{
+		DownloadedBytes: d.downloadedBytes,
+		TotalBytes:      d.totalBytes,
+	}
+	if elapsed := d.lastReportedAt.Sub(d.startedAt).Seconds(); elapsed > 0 {
+		progress.BytesPerSecond = int64(float64(d.downloadedBytes-d.startedBytes) / elapsed)
+	}
+	if progress.BytesPerSecond > 0 && progress.TotalBytes > progress.DownloadedBytes {
+		progress.ETA = time.Duration(progress.TotalBytes-progress.DownloadedBytes) * time.Second / time.Duration(progress.BytesPerSecond)
+	}
+
+	d.onProgress(ctx, progress)
+}
+
+type progressWriter struct {
+	ctx              context.Context
+	writer           io.Writer
+	progressReporter *downloadProgressReporter
+}
+
+func (p progressWriter) Write(b []byte) (int, error) {
+	writtenByteCount, err := p.writer.Write(b)
+	p.progressReporter.Add(p.ctx, int64(writtenByteCount))
+
+	return writtenByteCount, err
+}
@@ -2,15 +2,23 @@ package logic
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
 	"goload/internal/dataaccess/database"
 	"goload/internal/dataaccess/file"
 	"goload/internal/dataaccess/mq/producer"
@@ -19,17 +27,52 @@ import (
 )
 
 const (
-	downloadTaskMetadataFieldNameFileName = "file-name"
+	downloadTaskMetadataFieldNameFileName        = "file-name"
+	downloadTaskMetadataFieldNameDownloadedBytes = "downloaded-bytes"
+	downloadTaskMetadataFieldNameETag            = "etag"
+	downloadTaskMetadataFieldNameLastModified    = "last-modified"
+	downloadTaskMetadataFieldNameSegments        = "segments"
+
+	segmentFieldNameStart           = "start"
+	segmentFieldNameEnd             = "end"
+	segmentFieldNameDownloadedBytes = "downloaded-bytes"
+
+	downloadTaskRetryBatchSize            = 100
+	downloadTaskStuckBatchSize            = 100
+	downloadTaskInterruptionCheckInterval = time.Second
+	downloadTaskProgressPersistInterval   = 5 * time.Second
 )
 
 var (
-	errNotAllowToUpdateDownloadTask = status.Error(codes.PermissionDenied, "only owners can update their download tasks")
-	errNotAllowToDeleteDownloadTask = status.Error(codes.PermissionDenied, "only owners can delete their download tasks")
+	errNotAllowToUpdateDownloadTask  = status.Error(codes.PermissionDenied, "only owners and operators can update download tasks")
+	errNotAllowToDeleteDownloadTask  = status.Error(codes.PermissionDenied, "only owners and operators can delete download tasks")
+	errNotAllowToGetDownloadTaskFile = status.Error(codes.PermissionDenied, "only owners and operators can get download task files")
+	errDownloadTaskNotSuccess        = status.Error(codes.FailedPrecondition, "download task is not downloaded successfully")
+	errDownloadTaskFileNameNotFound  = status.Error(codes.Internal, "download task file name not found")
+	errUnsupportedDownloadType       = status.Error(codes.InvalidArgument, "download type is unsupported")
+	errNotAllowToCancelDownloadTask  = status.Error(codes.PermissionDenied, "only owners and operators can cancel download tasks")
+	errDownloadTaskNotCancelable     = status.Error(codes.FailedPrecondition, "download task is already finished")
+	errDownloadTaskStatusReadOnly    = status.Error(codes.InvalidArgument, "download task status can only be changed by canceling, pausing or resuming it")
+	errNotAllowToPauseDownloadTask   = status.Error(codes.PermissionDenied, "only owners and operators can pause download tasks")
+	errDownloadTaskNotPausable       = status.Error(codes.FailedPrecondition, "only pending or downloading download tasks can be paused")
+	errNotAllowToResumeDownloadTask  = status.Error(codes.PermissionDenied, "only owners and operators can resume download tasks")
+	errDownloadTaskNotPaused         = status.Error(codes.FailedPrecondition, "download task is not paused")
+	errDownloadTaskInterrupted       = status.Error(codes.Aborted, "download task is interrupted")
+	errNotAllowToGetDownloadTask     = status.Error(codes.PermissionDenied, "only owners and operators can get download tasks")
+	errDownloadTaskWorkerLost        = status.Error(codes.Unavailable, "download task worker stopped sending heartbeats")
+	errDownloadTaskRequeued          = status.Error(codes.Aborted, "download task is requeued after missing its heartbeats")
+	errInvalidHeartbeatConfig        = errors.New("heartbeat timeout must be longer than heartbeat interval")
+	errDownloadTaskNotFailable       = status.Error(codes.FailedPrecondition, "download task is already finished")
+	errDownloadTaskNotFailed         = status.Error(codes.FailedPrecondition, "only failed download tasks can be retried")
 )
 
 type CreateDownloadTaskInput struct {
 	OfAccountID uint64
 	URL         string
+	// MaxFileSizeInBytes can lower the maximum file size of the account for the task, zero keeps it.
+	MaxFileSizeInBytes uint64
+	// ExpectedChecksum fails the task if the downloaded file does not match it, nil skips the check.
+	ExpectedChecksum *goload.Checksum
 }
 
 type CreateDownloadTaskOutput struct {
@@ -47,6 +90,15 @@ type GetDownloadTaskListOutput struct {
 	TotalDownloadTaskCount uint64
 }
 
+type GetDownloadTaskInput struct {
+	OfAccountID    uint64
+	DownloadTaskID uint64
+}
+
+type GetDownloadTaskOutput struct {
+	DownloadTask *goload.DownloadTask
+}
+
 type UpdateDownloadTaskInput struct {
 	OfAccountID        uint64
 	DownloadTaskID     uint64
@@ -67,73 +119,246 @@ type DeleteDownloadTaskOutput struct {
 	Deleted bool
 }
 
+type CancelDownloadTaskInput struct {
+	OfAccountID    uint64
+	DownloadTaskID uint64
+}
+
+type CancelDownloadTaskOutput struct {
+	Canceled bool
+}
+
+type PauseDownloadTaskInput struct {
+	OfAccountID    uint64
+	DownloadTaskID uint64
+}
+
+type PauseDownloadTaskOutput struct {
+	Paused bool
+}
+
+type ResumeDownloadTaskInput struct {
+	OfAccountID    uint64
+	DownloadTaskID uint64
+}
+
+type ResumeDownloadTaskOutput struct {
+	Resumed bool
+}
+
+type GetDownloadTaskFileInput struct {
+	OfAccountID    uint64
+	DownloadTaskID uint64
+}
+
+type AdminGetDownloadTaskListInput struct {
+	OfAccountID uint64
+	// FilterAccountID only lists the download tasks of this account if it is not zero.
+	FilterAccountID uint64
+	Offset          uint64
+	Limit           uint64
+}
+
+type AdminGetDownloadTaskListOutput struct {
+	DownloadTaskList       []*goload.DownloadTask
+	TotalDownloadTaskCount uint64
+}
+
+type FailDownloadTaskInput struct {
+	OfAccountID    uint64
+	DownloadTaskID uint64
+	Reason         string
+}
+
+type FailDownloadTaskOutput struct {
+	Failed bool
+}
+
+type RetryDownloadTaskInput struct {
+	OfAccountID    uint64
+	DownloadTaskID uint64
+}
+
+type RetryDownloadTaskOutput struct {
+	Retried bool
+}
+
+// downloadTaskAttempt is one failed execution of a download task, kept in the task's attempt history.
+type downloadTaskAttempt struct {
+	Attempt       uint32     `json:"attempt"`
+	Error         string     `json:"error"`
+	Retryable     bool       `json:"retryable"`
+	FailedAt      time.Time  `json:"failed_at"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+}
+
 type DownloadTaskService interface {
+	// UpdateDownloadTask changes the url of a download task. Its status is changed with the methods below, any other
+	// status than the current one is rejected.
 	UpdateDownloadTask(ctx context.Context, input UpdateDownloadTaskInput) (UpdateDownloadTaskOutput, error)
 	CreateDownloadTask(ctx context.Context, input CreateDownloadTaskInput) (CreateDownloadTaskOutput, error)
 	DeleteDownloadTask(ctx context.Context, input DeleteDownloadTaskInput) (DeleteDownloadTaskOutput, error)
+	CancelDownloadTask(ctx context.Context, input CancelDownloadTaskInput) (CancelDownloadTaskOutput, error)
+	PauseDownloadTask(ctx context.Context, input PauseDownloadTaskInput) (PauseDownloadTaskOutput, error)
+	ResumeDownloadTask(ctx context.Context, input ResumeDownloadTaskInput) (ResumeDownloadTaskOutput, error)
 	GetDownloadTaskList(ctx context.Context, input GetDownloadTaskListInput) (GetDownloadTaskListOutput, error)
+	// GetDownloadTask returns a download task with its live progress if it is being downloaded.
+	GetDownloadTask(ctx context.Context, input GetDownloadTaskInput) (GetDownloadTaskOutput, error)
+	GetDownloadTaskFile(ctx context.Context, input GetDownloadTaskFileInput) (io.ReadCloser, error)
+	// AdminGetDownloadTaskList lists the download tasks of every account, newest first.
+	AdminGetDownloadTaskList(ctx context.Context, input AdminGetDownloadTaskListInput) (AdminGetDownloadTaskListOutput, error)
+	// FailDownloadTask marks an unfinished download task as failed, stopping it if it is being downloaded.
+	FailDownloadTask(ctx context.Context, input FailDownloadTaskInput) (FailDownloadTaskOutput, error)
+	// RetryDownloadTask executes a failed download task again, with a fresh set of attempts.
+	RetryDownloadTask(ctx context.Context, input RetryDownloadTaskInput) (RetryDownloadTaskOutput, error)
+	// GetAccountUsage reports how much of its download quota an account is using.
+	GetAccountUsage(ctx context.Context, input GetAccountUsageInput) (GetAccountUsageOutput, error)
 	ExecuteDownloadTask(ctx context.Context, id uint64) error
+	// EnqueueDueDownloadTaskRetries publishes the download tasks whose retry delay has elapsed.
+	EnqueueDueDownloadTaskRetries(ctx context.Context) error
+	// RequeueStuckDownloadTasks retries or fails the downloading tasks whose worker stopped sending heartbeats.
+	RequeueStuckDownloadTasks(ctx context.Context) error
 }
 
 type downloadTaskService struct {
-	database                    *goqu.Database
-	downloadTaskRepository      database.DownloadTaskRepository
-	accountRepository           database.AccountRepository
-	downloadTaskCreatedProvider producer.DownloadTaskCreatedProducer
-	fileClient                  file.Client
-	logger                      *zap.Logger
+	database                 *goqu.Database
+	downloadTaskRepository   database.DownloadTaskRepository
+	accountRepository        database.AccountRepository
+	outboxMessageRepository  database.OutboxMessageRepository
+	urlPolicyService         URLPolicyService
+	fileClient               file.Client
+	downloadTaskInterruption cache.DownloadTaskInterruption
+	downloadTaskProgress     cache.DownloadTaskProgress
+	downloadConfig           configs.Download
+	retryInitialBackoff      time.Duration
+	retryMaxBackoff          time.Duration
+	heartbeatInterval        time.Duration
+	heartbeatTimeout         time.Duration
+	quotaDeferInterval       time.Duration
+	egressPolicy             *egressPolicy
+	egressHTTPClient         *http.Client
+	logger                   *zap.Logger
 }
 
 func NewDownloadTaskService(
 	database *goqu.Database,
 	downloadTaskRepository database.DownloadTaskRepository,
 	accountRepository database.AccountRepository,
-	downloadTaskCreatedProvider producer.DownloadTaskCreatedProducer,
+	outboxMessageRepository database.OutboxMessageRepository,
+	urlPolicyService URLPolicyService,
 	fileClient file.Client,
+	downloadTaskInterruption cache.DownloadTaskInterruption,
+	downloadTaskProgress cache.DownloadTaskProgress,
+	downloadConfig configs.Download,
 	logger *zap.Logger,
-) DownloadTaskService {
-	return &downloadTaskService{
-		database:                    database,
-		downloadTaskRepository:      downloadTaskRepository,
-		accountRepository:           accountRepository,
-		downloadTaskCreatedProvider: downloadTaskCreatedProvider,
-		fileClient:                  fileClient,
-		logger:                      logger,
+) (DownloadTaskService, error) {
+	retryInitialBackoff, err := downloadConfig.Retry.GetInitialBackoffDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	retryMaxBackoff, err := downloadConfig.Retry.GetMaxBackoffDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatInterval, err := downloadConfig.Heartbeat.GetIntervalDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatTimeout, err := downloadConfig.Heartbeat.GetTimeoutDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	if heartbeatTimeout <= heartbeatInterval {
+		return nil, errInvalidHeartbeatConfig
+	}
+
+	quotaDeferInterval, err := downloadConfig.Quota.GetDeferIntervalDuration()
+	if err != nil {
+		return nil, err
 	}
+
+	egressPolicy, err := newEgressPolicy(downloadConfig.Egress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &downloadTaskService{
+		database:                 database,
+		downloadTaskRepository:   downloadTaskRepository,
+		accountRepository:        accountRepository,
+		outboxMessageRepository:  outboxMessageRepository,
+		urlPolicyService:         urlPolicyService,
+		fileClient:               fileClient,
+		downloadTaskInterruption: downloadTaskInterruption,
+		downloadTaskProgress:     downloadTaskProgress,
+		downloadConfig:           downloadConfig,
+		retryInitialBackoff:      retryInitialBackoff,
+		retryMaxBackoff:          retryMaxBackoff,
+		heartbeatInterval:        heartbeatInterval,
+		heartbeatTimeout:         heartbeatTimeout,
+		quotaDeferInterval:       quotaDeferInterval,
+		egressPolicy:             egressPolicy,
+		egressHTTPClient:         newEgressHTTPClient(egressPolicy),
+		logger:                   logger,
+	}, nil
 }
 
 // CreateDownloadTask implements DownloadTaskService.
 func (d *downloadTaskService) CreateDownloadTask(ctx context.Context, input CreateDownloadTaskInput) (CreateDownloadTaskOutput, error) {
+	if err := d.egressPolicy.checkURL(input.URL); err != nil {
+		return CreateDownloadTaskOutput{}, err
+	}
+
+	if err := validateExpectedChecksum(input.ExpectedChecksum); err != nil {
+		return CreateDownloadTaskOutput{}, err
+	}
+
 	account, getAccountErr := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
 	if getAccountErr != nil {
 		return CreateDownloadTaskOutput{}, getAccountErr
 	}
 
+	if err := d.urlPolicyService.CheckURL(ctx, account.ID, input.URL); err != nil {
+		return CreateDownloadTaskOutput{}, err
+	}
+
+	metadata := make(map[string]any)
+	if input.ExpectedChecksum != nil {
+		setExpectedChecksumToMetadata(metadata, input.ExpectedChecksum)
+	}
+	encodedMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return CreateDownloadTaskOutput{}, err
+	}
+
 	downloadTask := database.DownloadTask{
-		OfAccountID:    account.ID,
-		DownloadType:   goload.DownloadType_HTTP,
-		URL:            input.URL,
-		DownloadStatus: goload.DownloadStatus_Pending,
-		Metadata:       "{}",
+		OfAccountID:        account.ID,
+		DownloadType:       goload.DownloadType_HTTP,
+		URL:                input.URL,
+		DownloadStatus:     goload.DownloadStatus_Pending,
+		Metadata:           string(encodedMetadata),
+		MaxFileSizeInBytes: int64(input.MaxFileSizeInBytes),
 	}
 	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
-		downloadTaskID, createDownloadTaskErr := d.downloadTaskRepository.
+		if err := d.checkCreateDownloadTaskQuota(ctx, td, account.ID); err != nil {
+			return err
+		}
+
+		downloadTaskID, createdAt, createDownloadTaskErr := d.downloadTaskRepository.
 			WithDatabase(td).
 			CreateDownloadTask(ctx, downloadTask)
 		if createDownloadTaskErr != nil {
 			return createDownloadTaskErr
 		}
 		downloadTask.ID = downloadTaskID
+		downloadTask.CreatedAt = createdAt
+		downloadTask.UpdatedAt = createdAt
 
-		downloadTaskCreatedEvent := producer.DownloadTaskCreatedEvent{
-			DownloadTaskID: downloadTaskID,
-		}
-		produceErr := d.downloadTaskCreatedProvider.Produce(ctx, downloadTaskCreatedEvent)
-		if produceErr != nil {
-			return produceErr
-		}
-
-		return nil
+		return d.createDownloadTaskCreatedOutboxMessage(ctx, td, downloadTaskID)
 	})
 
 	if txnErr != nil {
@@ -141,12 +366,14 @@ func (d *downloadTaskService) CreateDownloadTask(ctx context.Context, input Crea
 	}
 
 	return CreateDownloadTaskOutput{
-		DownloadTask: d.toProtoDownloadTask(downloadTask, account),
+		DownloadTask: d.toProtoDownloadTask(ctx, downloadTask, account),
 	}, nil
 }
 
 // DeleteDownloadTask implements DownloadTaskService.
 func (d *downloadTaskService) DeleteDownloadTask(ctx context.Context, input DeleteDownloadTaskInput) (DeleteDownloadTaskOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", input.DownloadTaskID))
+
 	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
 	if err != nil {
 		return DeleteDownloadTaskOutput{}, err
@@ -157,20 +384,166 @@ func (d *downloadTaskService) DeleteDownloadTask(ctx context.Context, input Dele
 		return DeleteDownloadTaskOutput{}, err
 	}
 
-	if account.ID != downloadTask.OfAccountID {
+	if !canManageDownloadTask(account, downloadTask) {
 		return DeleteDownloadTaskOutput{}, errNotAllowToDeleteDownloadTask
 	}
 
+	if downloadTask.DownloadStatus == goload.DownloadStatus_Downloading {
+		// The worker executing the task stops once it sees this flag, finds the task gone and deletes the file it
+		// was still writing.
+		if err = d.downloadTaskInterruption.Set(ctx, input.DownloadTaskID); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to notify worker of download task deletion")
+		}
+	}
+
 	_, err = d.downloadTaskRepository.DeleteDownloadTask(ctx, input.DownloadTaskID)
 	if err != nil {
 		return DeleteDownloadTaskOutput{}, err
 	}
 
+	d.deleteDownloadTaskFile(ctx, input.DownloadTaskID)
+
 	return DeleteDownloadTaskOutput{
 		Deleted: true,
 	}, nil
 }
 
+// CancelDownloadTask implements DownloadTaskService.
+func (d *downloadTaskService) CancelDownloadTask(ctx context.Context, input CancelDownloadTaskInput) (CancelDownloadTaskOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", input.DownloadTaskID))
+
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return CancelDownloadTaskOutput{}, err
+	}
+
+	var previousDownloadStatus goload.DownloadStatus
+	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskRepository.WithDatabase(td).GetDownloadTaskByIDWithXLock(ctx, input.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		if !canManageDownloadTask(account, downloadTask) {
+			return errNotAllowToCancelDownloadTask
+		}
+
+		if downloadTask.DownloadStatus != goload.DownloadStatus_Pending &&
+			downloadTask.DownloadStatus != goload.DownloadStatus_Downloading &&
+			downloadTask.DownloadStatus != goload.DownloadStatus_Paused {
+			return errDownloadTaskNotCancelable
+		}
+
+		previousDownloadStatus = downloadTask.DownloadStatus
+		downloadTask.DownloadStatus = goload.DownloadStatus_Canceled
+		downloadTask.NextAttemptAt = sql.NullTime{}
+		downloadTask.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		_, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		return err
+	})
+	if txnErr != nil {
+		return CancelDownloadTaskOutput{}, txnErr
+	}
+
+	if previousDownloadStatus == goload.DownloadStatus_Downloading {
+		// The worker executing the task polls this flag, aborts the transfer and deletes the partial file itself.
+		if err = d.downloadTaskInterruption.Set(ctx, input.DownloadTaskID); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to notify worker of download task cancellation")
+		}
+	} else {
+		d.deleteDownloadTaskFile(ctx, input.DownloadTaskID)
+	}
+
+	return CancelDownloadTaskOutput{
+		Canceled: true,
+	}, nil
+}
+
+// PauseDownloadTask implements DownloadTaskService.
+func (d *downloadTaskService) PauseDownloadTask(ctx context.Context, input PauseDownloadTaskInput) (PauseDownloadTaskOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", input.DownloadTaskID))
+
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return PauseDownloadTaskOutput{}, err
+	}
+
+	var previousDownloadStatus goload.DownloadStatus
+	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskRepository.WithDatabase(td).GetDownloadTaskByIDWithXLock(ctx, input.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		if !canManageDownloadTask(account, downloadTask) {
+			return errNotAllowToPauseDownloadTask
+		}
+
+		if downloadTask.DownloadStatus != goload.DownloadStatus_Pending &&
+			downloadTask.DownloadStatus != goload.DownloadStatus_Downloading {
+			return errDownloadTaskNotPausable
+		}
+
+		previousDownloadStatus = downloadTask.DownloadStatus
+		downloadTask.DownloadStatus = goload.DownloadStatus_Paused
+		downloadTask.NextAttemptAt = sql.NullTime{}
+		_, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		return err
+	})
+	if txnErr != nil {
+		return PauseDownloadTaskOutput{}, txnErr
+	}
+
+	if previousDownloadStatus == goload.DownloadStatus_Downloading {
+		// The worker executing the task polls this flag, stops the transfer and saves how far it got.
+		if err = d.downloadTaskInterruption.Set(ctx, input.DownloadTaskID); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to notify worker of download task pause")
+		}
+	}
+
+	return PauseDownloadTaskOutput{
+		Paused: true,
+	}, nil
+}
+
+// ResumeDownloadTask implements DownloadTaskService.
+func (d *downloadTaskService) ResumeDownloadTask(ctx context.Context, input ResumeDownloadTaskInput) (ResumeDownloadTaskOutput, error) {
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return ResumeDownloadTaskOutput{}, err
+	}
+
+	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskRepository.WithDatabase(td).GetDownloadTaskByIDWithXLock(ctx, input.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		if !canManageDownloadTask(account, downloadTask) {
+			return errNotAllowToResumeDownloadTask
+		}
+
+		if downloadTask.DownloadStatus != goload.DownloadStatus_Paused {
+			return errDownloadTaskNotPaused
+		}
+
+		downloadTask.DownloadStatus = goload.DownloadStatus_Pending
+		if _, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask); err != nil {
+			return err
+		}
+
+		// The download checkpoint is kept in the task's metadata, so the worker picks up from the saved offset.
+		return d.createDownloadTaskCreatedOutboxMessage(ctx, td, downloadTask.ID)
+	})
+	if txnErr != nil {
+		return ResumeDownloadTaskOutput{}, txnErr
+	}
+
+	return ResumeDownloadTaskOutput{
+		Resumed: true,
+	}, nil
+}
+
 // GetDownloadTaskList implements DownloadTaskService.
 func (d *downloadTaskService) GetDownloadTaskList(ctx context.Context, input GetDownloadTaskListInput) (GetDownloadTaskListOutput, error) {
 	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
@@ -192,118 +565,912 @@ func (d *downloadTaskService) GetDownloadTaskList(ctx context.Context, input Get
 	return GetDownloadTaskListOutput{
 		TotalDownloadTaskCount: totalDownloadTaskCount,
 		DownloadTaskList: lo.Map(downloadTaskList, func(item database.DownloadTask, _ int) *goload.DownloadTask {
-			return d.toProtoDownloadTask(item, account)
+			return d.toProtoDownloadTask(ctx, item, account)
 		}),
 	}, nil
 }
 
-// UpdateDownloadTask implements DownloadTaskService.
-func (d *downloadTaskService) UpdateDownloadTask(ctx context.Context, input UpdateDownloadTaskInput) (UpdateDownloadTaskOutput, error) {
+// GetDownloadTask implements DownloadTaskService.
+func (d *downloadTaskService) GetDownloadTask(ctx context.Context, input GetDownloadTaskInput) (GetDownloadTaskOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", input.DownloadTaskID))
+
 	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
 	if err != nil {
-		return UpdateDownloadTaskOutput{}, err
+		return GetDownloadTaskOutput{}, err
 	}
 
 	downloadTask, err := d.downloadTaskRepository.GetDownloadTaskByID(ctx, input.DownloadTaskID)
 	if err != nil {
-		return UpdateDownloadTaskOutput{}, err
+		return GetDownloadTaskOutput{}, err
 	}
 
-	if account.ID != downloadTask.OfAccountID {
-		return UpdateDownloadTaskOutput{}, errNotAllowToUpdateDownloadTask
+	if !canManageDownloadTask(account, downloadTask) {
+		return GetDownloadTaskOutput{}, errNotAllowToGetDownloadTask
 	}
 
-	if input.URL != "" {
-		downloadTask.URL = input.URL
-	}
-	if input.DownloadTaskStatus != goload.DownloadStatus_UndefinedStatus {
-		downloadTask.DownloadStatus = input.DownloadTaskStatus
+	ownerAccount := account
+	if account.ID != downloadTask.OfAccountID {
+		if ownerAccount, err = d.accountRepository.GetAccountByID(ctx, downloadTask.OfAccountID); err != nil {
+			return GetDownloadTaskOutput{}, err
+		}
 	}
-	_, err = d.downloadTaskRepository.UpdateDownloadTask(ctx, downloadTask)
-	if err != nil {
-		return UpdateDownloadTaskOutput{}, err
+
+	protoDownloadTask := d.toProtoDownloadTask(ctx, downloadTask, ownerAccount)
+	if downloadTask.DownloadStatus == goload.DownloadStatus_Downloading {
+		// The database is only updated every few seconds, the cache has the latest progress.
+		progress, ok, err := d.downloadTaskProgress.Get(ctx, downloadTask.ID)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to get download task progress from cache")
+		} else if ok {
+			protoDownloadTask.Progress = &goload.DownloadProgress{
+				DownloadedBytes: uint64(progress.DownloadedBytes),
+				TotalBytes:      uint64(progress.TotalBytes),
+				BytesPerSecond:  uint64(progress.BytesPerSecond),
+				EtaSeconds:      uint64(progress.ETASeconds),
+			}
+		}
 	}
 
-	return UpdateDownloadTaskOutput{
-		Updated: true,
+	return GetDownloadTaskOutput{
+		DownloadTask: protoDownloadTask,
 	}, nil
 }
 
-// ExecuteDownloadTask implements DownloadTaskService.
-func (d *downloadTaskService) ExecuteDownloadTask(ctx context.Context, id uint64) error {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+// GetDownloadTaskFile implements DownloadTaskService.
+func (d *downloadTaskService) GetDownloadTaskFile(ctx context.Context, input GetDownloadTaskFileInput) (io.ReadCloser, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", input.DownloadTaskID))
 
-	updated, downloadTask, err := d.updateDownloadTaskStatusFromPendingToDownloading(ctx, id)
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
 	if err != nil {
-		return err
-	}
-	if !updated {
-		return nil
+		return nil, err
 	}
 
-	fileName := fmt.Sprintf("download_file_%d", id)
-	fileWriterCloser, err := d.fileClient.Write(ctx, fileName)
+	downloadTask, err := d.downloadTaskRepository.GetDownloadTaskByID(ctx, input.DownloadTaskID)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get download file writer")
-		d.updateDownloadTaskStatusToFailed(ctx, downloadTask)
-		return err
+		return nil, err
 	}
-	defer fileWriterCloser.Close()
 
-	var downloader Downloader
-	switch downloadTask.DownloadType {
-	case goload.DownloadType_HTTP:
-		downloader = NewHttpDownloader(downloadTask.URL, d.logger)
-	default:
-		logger.With(zap.Any("download_type", downloadTask.DownloadType)).Error("unsupported download type")
-		d.updateDownloadTaskStatusToFailed(ctx, downloadTask)
-		return nil
+	if !canManageDownloadTask(account, downloadTask) {
+		return nil, errNotAllowToGetDownloadTaskFile
 	}
 
-	metadata, err := downloader.Download(ctx, fileWriterCloser)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get download file")
-		d.updateDownloadTaskStatusToFailed(ctx, downloadTask)
-		return err
+	if downloadTask.DownloadStatus != goload.DownloadStatus_Success {
+		return nil, errDownloadTaskNotSuccess
 	}
 
-	metadata[downloadTaskMetadataFieldNameFileName] = fileName
-	downloadTask.DownloadStatus = goload.DownloadStatus_Success
-	encodedMetadata, err := json.Marshal(metadata)
+	metadata := make(map[string]any)
+	if err = json.Unmarshal([]byte(downloadTask.Metadata), &metadata); err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse download task metadata")
+		return nil, err
+	}
+
+	fileName, ok := metadata[downloadTaskMetadataFieldNameFileName].(string)
+	if !ok || fileName == "" {
+		logger.Error("download task metadata does not contain file name")
+		return nil, errDownloadTaskFileNameNotFound
+	}
+
+	return d.fileClient.Read(ctx, fileName)
+}
+
+// UpdateDownloadTask implements DownloadTaskService.
+func (d *downloadTaskService) UpdateDownloadTask(ctx context.Context, input UpdateDownloadTaskInput) (UpdateDownloadTaskOutput, error) {
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to stringify metadata")
-		return err
+		return UpdateDownloadTaskOutput{}, err
 	}
-	downloadTask.Metadata = string(encodedMetadata)
-	_, err = d.downloadTaskRepository.UpdateDownloadTask(ctx, downloadTask)
+
+	downloadTask, err := d.downloadTaskRepository.GetDownloadTaskByID(ctx, input.DownloadTaskID)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to update download task status to success")
-		return err
+		return UpdateDownloadTaskOutput{}, err
 	}
 
-	logger.With(zap.Uint64("id", id)).Info("download task is executed successfully")
+	if !canManageDownloadTask(account, downloadTask) {
+		return UpdateDownloadTaskOutput{}, errNotAllowToUpdateDownloadTask
+	}
 
-	return nil
-}
+	if input.URL != "" {
+		if err = d.egressPolicy.checkURL(input.URL); err != nil {
+			return UpdateDownloadTaskOutput{}, err
+		}
 
-func (d downloadTaskService) toProtoDownloadTask(
-	downloadTask database.DownloadTask,
-	account database.Account,
-) *goload.DownloadTask {
-	return &goload.DownloadTask{
-		Id: downloadTask.ID,
-		OfAccount: &goload.Account{
-			Id:          account.ID,
-			AccountName: account.AccountName,
-		},
-		DownloadType:   downloadTask.DownloadType,
-		Url:            downloadTask.URL,
-		DownloadStatus: downloadTask.DownloadStatus,
+		if err = d.urlPolicyService.CheckURL(ctx, downloadTask.OfAccountID, input.URL); err != nil {
+			return UpdateDownloadTaskOutput{}, err
+		}
 	}
-}
 
-func (d downloadTaskService) updateDownloadTaskStatusFromPendingToDownloading(
-	ctx context.Context,
+	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskRepository.WithDatabase(td).GetDownloadTaskByIDWithXLock(ctx, input.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		// The lifecycle methods also stop the worker, publish the change and keep the timestamps right, a raw write
+		// of the status would skip all of it.
+		if input.DownloadTaskStatus != goload.DownloadStatus_UndefinedStatus &&
+			input.DownloadTaskStatus != downloadTask.DownloadStatus {
+			return errDownloadTaskStatusReadOnly
+		}
+
+		if input.URL != "" {
+			downloadTask.URL = input.URL
+		}
+		_, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		return err
+	})
+	if txnErr != nil {
+		return UpdateDownloadTaskOutput{}, txnErr
+	}
+
+	return UpdateDownloadTaskOutput{
+		Updated: true,
+	}, nil
+}
+
+// AdminGetDownloadTaskList implements DownloadTaskService.
+func (d *downloadTaskService) AdminGetDownloadTaskList(
+	ctx context.Context,
+	input AdminGetDownloadTaskListInput,
+) (AdminGetDownloadTaskListOutput, error) {
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return AdminGetDownloadTaskListOutput{}, err
+	}
+
+	if err = checkPermission(account, PermissionManageAnyDownloadTask); err != nil {
+		return AdminGetDownloadTaskListOutput{}, err
+	}
+
+	var (
+		totalDownloadTaskCount uint64
+		downloadTaskList       []database.DownloadTask
+	)
+	if input.FilterAccountID != 0 {
+		totalDownloadTaskCount, err = d.downloadTaskRepository.CountDownloadTasksByOfAccountID(ctx, input.FilterAccountID)
+		if err != nil {
+			return AdminGetDownloadTaskListOutput{}, err
+		}
+
+		downloadTaskList, err = d.downloadTaskRepository.
+			GetDownloadTaskListByOfAccountID(ctx, input.FilterAccountID, input.Offset, input.Limit)
+		if err != nil {
+			return AdminGetDownloadTaskListOutput{}, err
+		}
+	} else {
+		totalDownloadTaskCount, err = d.downloadTaskRepository.CountDownloadTasks(ctx)
+		if err != nil {
+			return AdminGetDownloadTaskListOutput{}, err
+		}
+
+		downloadTaskList, err = d.downloadTaskRepository.GetDownloadTaskList(ctx, input.Offset, input.Limit)
+		if err != nil {
+			return AdminGetDownloadTaskListOutput{}, err
+		}
+	}
+
+	ownerAccountList, err := d.accountRepository.GetAccountListByIDList(ctx, lo.Uniq(lo.Map(
+		downloadTaskList, func(item database.DownloadTask, _ int) uint64 {
+			return item.OfAccountID
+		})))
+	if err != nil {
+		return AdminGetDownloadTaskListOutput{}, err
+	}
+
+	ownerAccountMap := lo.KeyBy(ownerAccountList, func(item database.Account) uint64 {
+		return item.ID
+	})
+
+	return AdminGetDownloadTaskListOutput{
+		TotalDownloadTaskCount: totalDownloadTaskCount,
+		DownloadTaskList: lo.Map(downloadTaskList, func(item database.DownloadTask, _ int) *goload.DownloadTask {
+			return d.toProtoDownloadTask(ctx, item, ownerAccountMap[item.OfAccountID])
+		}),
+	}, nil
+}
+
+// FailDownloadTask implements DownloadTaskService.
+func (d *downloadTaskService) FailDownloadTask(ctx context.Context, input FailDownloadTaskInput) (FailDownloadTaskOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("id", input.DownloadTaskID)).
+		With(zap.Uint64("of_account_id", input.OfAccountID))
+
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return FailDownloadTaskOutput{}, err
+	}
+
+	if err = checkPermission(account, PermissionManageAnyDownloadTask); err != nil {
+		return FailDownloadTaskOutput{}, err
+	}
+
+	var previousDownloadStatus goload.DownloadStatus
+	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskRepository.WithDatabase(td).GetDownloadTaskByIDWithXLock(ctx, input.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		if downloadTask.DownloadStatus != goload.DownloadStatus_Pending &&
+			downloadTask.DownloadStatus != goload.DownloadStatus_Downloading &&
+			downloadTask.DownloadStatus != goload.DownloadStatus_Paused {
+			return errDownloadTaskNotFailable
+		}
+
+		previousDownloadStatus = downloadTask.DownloadStatus
+		downloadTask.DownloadStatus = goload.DownloadStatus_Failed
+		downloadTask.NextAttemptAt = sql.NullTime{}
+		downloadTask.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		downloadTask.LastError = "failed by an operator"
+		if input.Reason != "" {
+			downloadTask.LastError = fmt.Sprintf("%s: %s", downloadTask.LastError, input.Reason)
+		}
+		_, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		return err
+	})
+	if txnErr != nil {
+		return FailDownloadTaskOutput{}, txnErr
+	}
+
+	if previousDownloadStatus == goload.DownloadStatus_Downloading {
+		// The worker executing the task polls this flag and stops the transfer, keeping the partial file.
+		if err = d.downloadTaskInterruption.Set(ctx, input.DownloadTaskID); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to notify worker of download task failure")
+		}
+	}
+
+	logger.With(zap.String("reason", input.Reason)).Info("download task is failed by an operator")
+	return FailDownloadTaskOutput{
+		Failed: true,
+	}, nil
+}
+
+// RetryDownloadTask implements DownloadTaskService.
+func (d *downloadTaskService) RetryDownloadTask(ctx context.Context, input RetryDownloadTaskInput) (RetryDownloadTaskOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("id", input.DownloadTaskID)).
+		With(zap.Uint64("of_account_id", input.OfAccountID))
+
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return RetryDownloadTaskOutput{}, err
+	}
+
+	if err = checkPermission(account, PermissionManageAnyDownloadTask); err != nil {
+		return RetryDownloadTaskOutput{}, err
+	}
+
+	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskRepository.WithDatabase(td).GetDownloadTaskByIDWithXLock(ctx, input.DownloadTaskID)
+		if err != nil {
+			return err
+		}
+
+		if downloadTask.DownloadStatus != goload.DownloadStatus_Failed {
+			return errDownloadTaskNotFailed
+		}
+
+		// The attempt history is kept, but the task gets as many attempts as a new one.
+		downloadTask.DownloadStatus = goload.DownloadStatus_Pending
+		downloadTask.AttemptCount = 0
+		downloadTask.NextAttemptAt = sql.NullTime{}
+		downloadTask.CompletedAt = sql.NullTime{}
+		if _, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask); err != nil {
+			return err
+		}
+
+		return d.createDownloadTaskCreatedOutboxMessage(ctx, td, downloadTask.ID)
+	})
+	if txnErr != nil {
+		return RetryDownloadTaskOutput{}, txnErr
+	}
+
+	logger.Info("download task is retried by an operator")
+	return RetryDownloadTaskOutput{
+		Retried: true,
+	}, nil
+}
+
+// EnqueueDueDownloadTaskRetries implements DownloadTaskService.
+func (d *downloadTaskService) EnqueueDueDownloadTaskRetries(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	return d.database.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTaskList, err := d.downloadTaskRepository.
+			WithDatabase(td).
+			GetDueRetryDownloadTaskListWithXLock(ctx, time.Now(), downloadTaskRetryBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, downloadTask := range downloadTaskList {
+			downloadTask.NextAttemptAt = sql.NullTime{}
+			if _, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask); err != nil {
+				return err
+			}
+
+			if err = d.createDownloadTaskCreatedOutboxMessage(ctx, td, downloadTask.ID); err != nil {
+				return err
+			}
+
+			logger.
+				With(zap.Uint64("id", downloadTask.ID)).
+				With(zap.Uint32("attempt_count", downloadTask.AttemptCount)).
+				Info("download task is enqueued for retry")
+		}
+
+		return nil
+	})
+}
+
+// RequeueStuckDownloadTasks implements DownloadTaskService.
+func (d *downloadTaskService) RequeueStuckDownloadTasks(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	return d.database.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTaskList, err := d.downloadTaskRepository.
+			WithDatabase(td).
+			GetStaleDownloadingDownloadTaskListWithXLock(ctx, time.Now().Add(-d.heartbeatTimeout), downloadTaskStuckBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, downloadTask := range downloadTaskList {
+			// A lost worker counts as a failed attempt. A task that is set back to pending is published again by
+			// EnqueueDueDownloadTaskRetries once its backoff has elapsed.
+			retryScheduled := d.applyDownloadTaskFailure(ctx, &downloadTask, errDownloadTaskWorkerLost, time.Now())
+			if _, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask); err != nil {
+				return err
+			}
+
+			logger.
+				With(zap.Uint64("id", downloadTask.ID)).
+				With(zap.Uint32("attempt_count", downloadTask.AttemptCount)).
+				With(zap.Bool("retry_scheduled", retryScheduled)).
+				Warn("stuck download task is reaped")
+		}
+
+		return nil
+	})
+}
+
+// ExecuteDownloadTask implements DownloadTaskService.
+func (d *downloadTaskService) ExecuteDownloadTask(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	updated, downloadTask, err := d.updateDownloadTaskStatusFromPendingToDownloading(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return nil
+	}
+
+	var downloader Downloader
+	switch downloadTask.DownloadType {
+	case goload.DownloadType_HTTP:
+		// The url policies may have changed since the task was created.
+		if err = d.urlPolicyService.CheckURL(ctx, downloadTask.OfAccountID, downloadTask.URL); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to check download url against url policies")
+			_, denied := newEgressDownloadError(err)
+			d.recordDownloadTaskFailure(ctx, downloadTask, DownloadError{Err: err, Retryable: !denied})
+			return nil
+		}
+
+		account, getAccountErr := d.accountRepository.GetAccountByID(ctx, downloadTask.OfAccountID)
+		if getAccountErr != nil {
+			logger.With(zap.Error(getAccountErr)).Error("failed to get account of download task")
+			d.recordDownloadTaskFailure(ctx, downloadTask, getAccountErr)
+			return nil
+		}
+
+		httpClient := withRedirectCheck(d.egressHTTPClient, func(ctx context.Context, rawURL string) error {
+			return d.urlPolicyService.CheckURL(ctx, downloadTask.OfAccountID, rawURL)
+		})
+		downloader = NewHttpDownloader(
+			downloadTask.URL,
+			httpClient,
+			d.getDownloadLimit(account, downloadTask),
+			d.downloadConfig.ResumeMaxAttempts,
+			d.downloadConfig.SegmentCount,
+			d.downloadConfig.MinSegmentSizeInBytes,
+			d.logger,
+		)
+	default:
+		logger.With(zap.Any("download_type", downloadTask.DownloadType)).Error("unsupported download type")
+		d.recordDownloadTaskFailure(ctx, downloadTask, DownloadError{Err: errUnsupportedDownloadType})
+		return nil
+	}
+
+	storedBytes, quotaErr := d.checkStorageQuotaBeforeDownload(ctx, downloadTask)
+	if quotaErr != nil {
+		logger.Info("account has used up its storage quota, failing download task")
+		d.recordDownloadTaskFailure(ctx, downloadTask, DownloadError{Err: quotaErr})
+		return nil
+	}
+
+	metadata := d.parseDownloadTaskMetadata(ctx, downloadTask)
+	checkpoint := d.getDownloadCheckpointFromMetadata(metadata)
+	fileName := d.getDownloadTaskFileName(id)
+
+	downloadCtx, cancelDownload := context.WithCancelCause(ctx)
+	defer cancelDownload(nil)
+
+	onProgress := d.newDownloadQuotaEnforcer(id, storedBytes, cancelDownload, d.newDownloadProgressSaver(&downloadTask))
+	// The version is read before the download starts, so that a request made while it is running is not mistaken
+	// for one that was meant for an earlier execution.
+	if interruptionVersion, err := d.downloadTaskInterruption.Get(ctx, id); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to get download task interruption, the task can not be interrupted")
+	} else {
+		go d.watchDownloadTaskInterruption(downloadCtx, id, interruptionVersion, cancelDownload)
+	}
+	go d.sendDownloadTaskHeartbeats(downloadCtx, id, downloadTask.HeartbeatAt.Time, cancelDownload)
+
+	var (
+		downloadMetadata map[string]any
+		downloaded       = false
+		digester         = newFileDigester()
+	)
+	segmentedDownloader, ok := downloader.(SegmentedDownloader)
+	if ok && (checkpoint.DownloadedBytes == 0 || len(checkpoint.Segments) > 0) {
+		downloaded, downloadMetadata, checkpoint, err = d.downloadSegments(
+			downloadCtx, &downloadTask, metadata, segmentedDownloader, fileName, checkpoint, digester, onProgress)
+	}
+	if !downloaded && err == nil {
+		downloadMetadata, checkpoint, err = d.download(
+			downloadCtx, &downloadTask, metadata, downloader, fileName, checkpoint, digester, onProgress)
+	}
+	if errors.Is(err, ErrDownloadResourceChanged) {
+		logger.Info("downloaded resource has changed, restarting download from the beginning")
+		downloadMetadata, checkpoint, err = d.download(
+			downloadCtx, &downloadTask, metadata, downloader, fileName, DownloadCheckpoint{}, digester, onProgress)
+	}
+	if errors.Is(context.Cause(downloadCtx), errDownloadTaskRequeued) {
+		// Another worker owns the task and its file now, nothing of this download may be saved.
+		logger.Warn("download task is requeued while downloading, dropped download")
+		return nil
+	}
+	if errors.Is(context.Cause(downloadCtx), errDownloadTaskInterrupted) {
+		logger.Info("download task is interrupted, stopped download")
+		d.handleDownloadTaskInterruption(ctx, downloadTask, metadata, checkpoint)
+		return nil
+	}
+	if quotaErr := context.Cause(downloadCtx); errors.Is(quotaErr, errStorageQuotaExceeded) {
+		d.failDownloadTaskAndDeleteFile(ctx, downloadTask, metadata, DownloadError{Err: quotaErr})
+		return nil
+	}
+	if isDownloadLimitError(err) {
+		logger.With(zap.Error(err)).Info("download task exceeds its download limit")
+		d.failDownloadTaskAndDeleteFile(ctx, downloadTask, metadata, err)
+		return nil
+	}
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download file")
+		d.setDownloadCheckpointToMetadata(metadata, checkpoint)
+		if encodedMetadata, encodeErr := json.Marshal(metadata); encodeErr == nil {
+			downloadTask.Metadata = string(encodedMetadata)
+		}
+		if d.recordDownloadTaskFailure(ctx, downloadTask, err) {
+			return nil
+		}
+
+		return err
+	}
+
+	digester.setToMetadata(metadata)
+	if expectedChecksum, ok := getExpectedChecksumFromMetadata(metadata); ok {
+		if err = digester.verify(expectedChecksum); err != nil {
+			logger.With(zap.Error(err)).Warn("downloaded file does not match the expected checksum")
+			d.failDownloadTaskAndDeleteFile(ctx, downloadTask, metadata, err)
+			return nil
+		}
+	}
+
+	for key, value := range downloadMetadata {
+		metadata[key] = value
+	}
+	metadata[downloadTaskMetadataFieldNameFileName] = fileName
+	d.setDownloadCheckpointToMetadata(metadata, checkpoint)
+	downloadTask.DownloadStatus = goload.DownloadStatus_Success
+	downloadTask.DownloadedBytes = checkpoint.DownloadedBytes
+	downloadTask.TotalBytes = checkpoint.DownloadedBytes
+	downloadTask.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	// Errors of earlier attempts are still in the attempt history.
+	downloadTask.LastError = ""
+	encodedMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to stringify metadata")
+		return err
+	}
+	downloadTask.Metadata = string(encodedMetadata)
+	if errors.Is(context.Cause(downloadCtx), errDownloadTaskRequeued) {
+		logger.Warn("download task is requeued while downloading, dropped download")
+		return nil
+	}
+	updated, err = d.updateDownloadingDownloadTask(ctx, downloadTask)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update download task status to success")
+		return err
+	}
+	if !updated {
+		logger.Info("download task is no longer downloading")
+		d.handleDownloadTaskInterruption(ctx, downloadTask, metadata, checkpoint)
+		return nil
+	}
+
+	logger.With(zap.Uint64("id", id)).Info("download task is executed successfully")
+
+	return nil
+}
+
+// createDownloadTaskCreatedOutboxMessage queues a DownloadTaskCreatedEvent in the outbox as part of td, so that the
+// event is published if and only if td is committed.
+func (d downloadTaskService) createDownloadTaskCreatedOutboxMessage(
+	ctx context.Context,
+	td *goqu.TxDatabase,
+	downloadTaskID uint64,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTaskID))
+
+	payload, err := json.Marshal(producer.DownloadTaskCreatedEvent{
+		DownloadTaskID: downloadTaskID,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal download task created event")
+		return err
+	}
+
+	_, err = d.outboxMessageRepository.WithDatabase(td).CreateOutboxMessage(ctx, database.OutboxMessage{
+		Topic:   producer.MessageQueueTopicDownloadTaskCreated,
+		Payload: payload,
+	})
+	return err
+}
+
+// download opens the download file at the checkpoint and runs the downloader into it. The checkpoint is saved
+// into the task's metadata as the download goes, so that another worker can pick up where this one stopped.
+// Everything written to the file, including what was downloaded before the checkpoint, goes through digester.
+func (d downloadTaskService) download(
+	ctx context.Context,
+	downloadTask *database.DownloadTask,
+	metadata map[string]any,
+	downloader Downloader,
+	fileName string,
+	checkpoint DownloadCheckpoint,
+	digester *fileDigester,
+	onProgress DownloadProgressFunc,
+) (map[string]any, DownloadCheckpoint, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	// The written bytes are kept even if the download is interrupted, so the file must outlive ctx.
+	fileCtx := context.WithoutCancel(ctx)
+	digester.reset()
+	if checkpoint.DownloadedBytes > 0 {
+		if err := d.digestDownloadTaskFile(fileCtx, fileName, checkpoint.DownloadedBytes, digester); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to hash downloaded part of file, restarting download from the beginning")
+			digester.reset()
+			checkpoint = DownloadCheckpoint{}
+		}
+	}
+
+	fileWriterCloser, err := d.fileClient.Append(fileCtx, fileName, checkpoint.DownloadedBytes)
+	if errors.Is(err, file.ErrAppendUnsupported) {
+		logger.Info("download file can not be resumed, restarting download from the beginning")
+		digester.reset()
+		checkpoint = DownloadCheckpoint{}
+		fileWriterCloser, err = d.fileClient.Write(fileCtx, fileName)
+	}
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download file writer")
+		return nil, checkpoint, err
+	}
+
+	downloadMetadata, checkpoint, err := downloader.Download(
+		ctx,
+		digestWriter{
+			writer:   fileWriterCloser,
+			digester: digester,
+		},
+		checkpoint,
+		func(ctx context.Context, checkpoint DownloadCheckpoint) {
+			d.saveDownloadCheckpoint(ctx, downloadTask, metadata, checkpoint)
+		},
+		onProgress,
+	)
+	if closeErr := fileWriterCloser.Close(); closeErr != nil && err == nil {
+		logger.With(zap.Error(closeErr)).Error("failed to close download file writer")
+		err = closeErr
+	}
+
+	return downloadMetadata, checkpoint, err
+}
+
+// downloadSegments downloads the file over several connections when the source supports it. It reports false
+// without an error when the file should be downloaded as a single stream instead. Each segment is written into its
+// own part file and checkpointed on its own, so that an interrupted download continues every segment from its
+// part. The parts are composed into the download file and read back into digester once they are complete.
+func (d downloadTaskService) downloadSegments(
+	ctx context.Context,
+	downloadTask *database.DownloadTask,
+	metadata map[string]any,
+	segmentedDownloader SegmentedDownloader,
+	fileName string,
+	checkpoint DownloadCheckpoint,
+	digester *fileDigester,
+	onProgress DownloadProgressFunc,
+) (bool, map[string]any, DownloadCheckpoint, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	if len(checkpoint.Segments) == 0 && d.downloadConfig.SegmentCount < 2 {
+		return false, nil, DownloadCheckpoint{}, nil
+	}
+
+	probe, err := segmentedDownloader.Probe(ctx)
+	if err != nil {
+		// The downloaded segments are kept for the next attempt rather than thrown away for a single stream.
+		if _, denied := newEgressDownloadError(err); denied || len(checkpoint.Segments) > 0 {
+			return true, nil, checkpoint, err
+		}
+
+		logger.With(zap.Error(err)).Warn("failed to probe download, falling back to single stream")
+		return false, nil, DownloadCheckpoint{}, nil
+	}
+
+	segmentCount := max(len(checkpoint.Segments), probe.SegmentCount)
+	if probe.SegmentCount < 2 {
+		if len(checkpoint.Segments) > 0 {
+			logger.Info("download can no longer be segmented, restarting download from the beginning")
+			d.deleteDownloadTaskPartFiles(ctx, downloadTask.ID, segmentCount)
+		}
+
+		return false, nil, DownloadCheckpoint{}, nil
+	}
+
+	// The written bytes are kept even if the download is interrupted, so the files must outlive ctx.
+	fileCtx := context.WithoutCancel(ctx)
+	downloadMetadata, checkpoint, err := segmentedDownloader.DownloadSegments(
+		ctx,
+		func(ctx context.Context, index int, offset int64) (io.WriteCloser, int64, error) {
+			partFileName := d.getDownloadTaskPartFileName(downloadTask.ID, index)
+			partWriteCloser, err := d.fileClient.Append(fileCtx, partFileName, offset)
+			if errors.Is(err, file.ErrAppendUnsupported) {
+				partWriteCloser, err = d.fileClient.Write(fileCtx, partFileName)
+				offset = 0
+			}
+
+			return partWriteCloser, offset, err
+		},
+		probe,
+		checkpoint,
+		func(ctx context.Context, checkpoint DownloadCheckpoint) {
+			d.saveDownloadCheckpoint(ctx, downloadTask, metadata, checkpoint)
+		},
+		onProgress,
+	)
+	if errors.Is(err, ErrDownloadResourceChanged) {
+		d.deleteDownloadTaskPartFiles(ctx, downloadTask.ID, segmentCount)
+	}
+	if err != nil {
+		return true, nil, checkpoint, err
+	}
+
+	partFileNameList := make([]string, len(checkpoint.Segments))
+	for i := range partFileNameList {
+		partFileNameList[i] = d.getDownloadTaskPartFileName(downloadTask.ID, i)
+	}
+
+	if err = d.fileClient.Compose(fileCtx, fileName, partFileNameList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to compose download file from its parts")
+		return true, nil, checkpoint, err
+	}
+	checkpoint.Segments = nil
+
+	digester.reset()
+	if err = d.digestDownloadTaskFile(fileCtx, fileName, checkpoint.DownloadedBytes, digester); err != nil {
+		logger.With(zap.Error(err)).Error("failed to hash downloaded file")
+		return true, nil, DownloadCheckpoint{}, err
+	}
+
+	return true, downloadMetadata, checkpoint, nil
+}
+
+// digestDownloadTaskFile writes the first size bytes of the download file into digester.
+func (d downloadTaskService) digestDownloadTaskFile(
+	ctx context.Context,
+	fileName string,
+	size int64,
+	digester *fileDigester,
+) error {
+	fileReadCloser, err := d.fileClient.Read(ctx, fileName)
+	if err != nil {
+		return err
+	}
+	defer fileReadCloser.Close()
+
+	_, err = io.CopyN(digester, fileReadCloser, size)
+	return err
+}
+
+// newDownloadProgressSaver publishes the progress of downloadTask to the cache every time it is reported, and saves
+// it into the database every downloadTaskProgressPersistInterval.
+func (d downloadTaskService) newDownloadProgressSaver(downloadTask *database.DownloadTask) DownloadProgressFunc {
+	lastPersistedAt := time.Now()
+
+	return func(ctx context.Context, progress DownloadProgress) {
+		logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+		err := d.downloadTaskProgress.Set(ctx, downloadTask.ID, cache.DownloadTaskProgressEntry{
+			DownloadedBytes: progress.DownloadedBytes,
+			TotalBytes:      progress.TotalBytes,
+			BytesPerSecond:  progress.BytesPerSecond,
+			ETASeconds:      int64(progress.ETA.Seconds()),
+		})
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to set download task progress into cache")
+		}
+
+		downloadTask.DownloadedBytes = progress.DownloadedBytes
+		downloadTask.TotalBytes = progress.TotalBytes
+		downloadTask.BytesPerSecond = progress.BytesPerSecond
+		if time.Since(lastPersistedAt) < downloadTaskProgressPersistInterval {
+			return
+		}
+
+		lastPersistedAt = time.Now()
+		if _, err = d.updateDownloadingDownloadTask(ctx, *downloadTask); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to save download task progress")
+		}
+	}
+}
+
+func (d downloadTaskService) parseDownloadTaskMetadata(ctx context.Context, downloadTask database.DownloadTask) map[string]any {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	metadata := make(map[string]any)
+	if err := json.Unmarshal([]byte(downloadTask.Metadata), &metadata); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to parse download task metadata, ignoring it")
+		return make(map[string]any)
+	}
+
+	return metadata
+}
+
+func (d downloadTaskService) getDownloadCheckpointFromMetadata(metadata map[string]any) DownloadCheckpoint {
+	checkpoint := DownloadCheckpoint{}
+	// JSON numbers are decoded as float64.
+	if downloadedBytes, ok := metadata[downloadTaskMetadataFieldNameDownloadedBytes].(float64); ok {
+		checkpoint.DownloadedBytes = int64(downloadedBytes)
+	}
+	if eTag, ok := metadata[downloadTaskMetadataFieldNameETag].(string); ok {
+		checkpoint.ETag = eTag
+	}
+	if lastModified, ok := metadata[downloadTaskMetadataFieldNameLastModified].(string); ok {
+		checkpoint.LastModified = lastModified
+	}
+
+	segmentList, _ := metadata[downloadTaskMetadataFieldNameSegments].([]any)
+	for _, segmentValue := range segmentList {
+		segment, ok := segmentValue.(map[string]any)
+		if !ok {
+			return DownloadCheckpoint{}
+		}
+
+		start, startOK := segment[segmentFieldNameStart].(float64)
+		end, endOK := segment[segmentFieldNameEnd].(float64)
+		downloadedBytes, downloadedBytesOK := segment[segmentFieldNameDownloadedBytes].(float64)
+		if !startOK || !endOK || !downloadedBytesOK {
+			return DownloadCheckpoint{}
+		}
+
+		checkpoint.Segments = append(checkpoint.Segments, DownloadSegmentCheckpoint{
+			Start:           int64(start),
+			End:             int64(end),
+			DownloadedBytes: int64(downloadedBytes),
+		})
+	}
+
+	return checkpoint
+}
+
+func (d downloadTaskService) setDownloadCheckpointToMetadata(metadata map[string]any, checkpoint DownloadCheckpoint) {
+	metadata[downloadTaskMetadataFieldNameDownloadedBytes] = checkpoint.DownloadedBytes
+	metadata[downloadTaskMetadataFieldNameETag] = checkpoint.ETag
+	metadata[downloadTaskMetadataFieldNameLastModified] = checkpoint.LastModified
+
+	if len(checkpoint.Segments) == 0 {
+		delete(metadata, downloadTaskMetadataFieldNameSegments)
+		return
+	}
+
+	segmentList := make([]any, 0, len(checkpoint.Segments))
+	for _, segment := range checkpoint.Segments {
+		segmentList = append(segmentList, map[string]any{
+			segmentFieldNameStart:           segment.Start,
+			segmentFieldNameEnd:             segment.End,
+			segmentFieldNameDownloadedBytes: segment.DownloadedBytes,
+		})
+	}
+	metadata[downloadTaskMetadataFieldNameSegments] = segmentList
+}
+
+func (d downloadTaskService) saveDownloadCheckpoint(
+	ctx context.Context,
+	downloadTask *database.DownloadTask,
+	metadata map[string]any,
+	checkpoint DownloadCheckpoint,
+) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	d.setDownloadCheckpointToMetadata(metadata, checkpoint)
+	encodedMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to stringify metadata")
+		return
+	}
+
+	downloadTask.Metadata = string(encodedMetadata)
+	if _, err = d.updateDownloadingDownloadTask(ctx, *downloadTask); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to save download checkpoint")
+	}
+}
+
+func (d downloadTaskService) toProtoDownloadTask(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	account database.Account,
+) *goload.DownloadTask {
+	metadata := d.parseDownloadTaskMetadata(ctx, downloadTask)
+	fileSize, ok := metadata[downloadTaskMetadataFieldNameFileSize].(float64)
+	if !ok && downloadTask.DownloadStatus == goload.DownloadStatus_Success {
+		// Tasks downloaded before the file size was recorded.
+		fileSize = float64(downloadTask.DownloadedBytes)
+	}
+	contentType, _ := metadata[HTTPMetadataKeyContentType].(string)
+	fileName, _ := metadata[downloadTaskMetadataFieldNameFileName].(string)
+
+	protoDownloadTask := &goload.DownloadTask{
+		Id: downloadTask.ID,
+		OfAccount: &goload.Account{
+			Id:          account.ID,
+			AccountName: account.AccountName,
+		},
+		DownloadType:   downloadTask.DownloadType,
+		Url:            downloadTask.URL,
+		DownloadStatus: downloadTask.DownloadStatus,
+		Progress:       d.toProtoDownloadProgress(downloadTask),
+		Checksums:      getChecksumListFromMetadata(metadata),
+		FileSize:       uint64(max(fileSize, 0)),
+		ContentType:    contentType,
+		FileName:       fileName,
+		ErrorMessage:   downloadTask.LastError,
+		CreatedAt:      timestamppb.New(downloadTask.CreatedAt),
+		UpdatedAt:      timestamppb.New(downloadTask.UpdatedAt),
+	}
+	if downloadTask.CompletedAt.Valid {
+		protoDownloadTask.CompletedAt = timestamppb.New(downloadTask.CompletedAt.Time)
+	}
+
+	return protoDownloadTask
+}
+
+func (d downloadTaskService) toProtoDownloadProgress(downloadTask database.DownloadTask) *goload.DownloadProgress {
+	progress := &goload.DownloadProgress{
+		DownloadedBytes: uint64(downloadTask.DownloadedBytes),
+		TotalBytes:      uint64(downloadTask.TotalBytes),
+	}
+
+	// Throughput and ETA only mean something while the task is being downloaded.
+	if downloadTask.DownloadStatus == goload.DownloadStatus_Downloading && downloadTask.BytesPerSecond > 0 {
+		progress.BytesPerSecond = uint64(downloadTask.BytesPerSecond)
+		if downloadTask.TotalBytes > downloadTask.DownloadedBytes {
+			progress.EtaSeconds = uint64((downloadTask.TotalBytes - downloadTask.DownloadedBytes) / downloadTask.BytesPerSecond)
+		}
+	}
+
+	return progress
+}
+
+func (d downloadTaskService) updateDownloadTaskStatusFromPendingToDownloading(
+	ctx context.Context,
 	id uint64,
 ) (bool, database.DownloadTask, error) {
 	var (
@@ -325,12 +1492,46 @@ func (d downloadTaskService) updateDownloadTaskStatusFromPendingToDownloading(
 			return nil
 		}
 
+		if downloadTask.NextAttemptAt.Valid && downloadTask.NextAttemptAt.Time.After(time.Now()) {
+			logger.Warn("download task is waiting for its retry delay")
+			return nil
+		}
+
+		var quotaExceeded bool
+		quotaExceeded, err = d.isDownloadingQuotaExceeded(ctx, td, downloadTask.OfAccountID)
+		if err != nil {
+			return err
+		}
+
+		if quotaExceeded {
+			// The task is deferred rather than failed, it does not count as an attempt.
+			downloadTask.NextAttemptAt = sql.NullTime{Time: time.Now().Add(d.quotaDeferInterval), Valid: true}
+			_, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+			if err != nil {
+				logger.With(zap.Error(err)).Error("failed to defer download task")
+				return err
+			}
+
+			logger.
+				With(zap.Time("next_attempt_at", downloadTask.NextAttemptAt.Time)).
+				Info("account has too many downloading tasks, deferred download task")
+			return nil
+		}
+
 		downloadTask.DownloadStatus = goload.DownloadStatus_Downloading
 		_, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
 		if err != nil {
 			logger.With(zap.Error(err)).Error("failed to update download task to downloading")
 			return err
 		}
+
+		// Postgres keeps microseconds, the heartbeat has to be stored exactly to be renewed later.
+		heartbeatAt := time.Now().Truncate(time.Microsecond)
+		_, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTaskHeartbeat(ctx, id, heartbeatAt)
+		if err != nil {
+			return err
+		}
+		downloadTask.HeartbeatAt = sql.NullTime{Time: heartbeatAt, Valid: true}
 		updated = true
 
 		return nil
@@ -342,12 +1543,309 @@ func (d downloadTaskService) updateDownloadTaskStatusFromPendingToDownloading(
 	return updated, downloadTask, nil
 }
 
-func (d downloadTaskService) updateDownloadTaskStatusToFailed(ctx context.Context, downloadTask database.DownloadTask) {
+// updateDownloadingDownloadTask saves downloadTask only if it is still downloading, so that a worker does not
+// overwrite a status that was changed while it was executing the task, such as a pause or a cancellation.
+func (d downloadTaskService) updateDownloadingDownloadTask(ctx context.Context, downloadTask database.DownloadTask) (bool, error) {
+	if errors.Is(context.Cause(ctx), errDownloadTaskRequeued) {
+		return false, nil
+	}
+
+	updated := false
+	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
+		currentDownloadTask, err := d.downloadTaskRepository.WithDatabase(td).GetDownloadTaskByIDWithXLock(ctx, downloadTask.ID)
+		if err != nil {
+			return err
+		}
+
+		if currentDownloadTask.DownloadStatus != goload.DownloadStatus_Downloading {
+			return nil
+		}
+
+		if _, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask); err != nil {
+			return err
+		}
+		updated = true
+
+		return nil
+	})
+	if txnErr != nil {
+		return false, txnErr
+	}
+
+	return updated, nil
+}
+
+// watchDownloadTaskInterruption stops the download of a task once it is asked to, that is once the version of the
+// interruption flag is no longer the one this execution started with.
+func (d downloadTaskService) watchDownloadTaskInterruption(
+	ctx context.Context,
+	id uint64,
+	startedVersion int64,
+	cancelDownload context.CancelCauseFunc,
+) {
+	ticker := time.NewTicker(downloadTaskInterruptionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			version, err := d.downloadTaskInterruption.Get(ctx, id)
+			// A flag that expired while the task was executing reads as 0, which is not a request to stop.
+			if err == nil && version != 0 && version != startedVersion {
+				cancelDownload(errDownloadTaskInterrupted)
+				return
+			}
+		}
+	}
+}
+
+// sendDownloadTaskHeartbeats keeps the heartbeat of a download task fresh while this worker is executing it, so
+// that the task is not reaped as stuck. Once the heartbeat can not be renewed the task is no longer this worker's,
+// and the download is stopped.
+func (d downloadTaskService) sendDownloadTaskHeartbeats(
+	ctx context.Context,
+	id uint64,
+	lastHeartbeatAt time.Time,
+	cancelDownload context.CancelCauseFunc,
+) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	ticker := time.NewTicker(d.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			heartbeatAt := time.Now().Truncate(time.Microsecond)
+			renewed, err := d.downloadTaskRepository.RenewDownloadTaskHeartbeat(ctx, id, lastHeartbeatAt, heartbeatAt)
+			if err != nil {
+				logger.With(zap.Error(err)).Warn("failed to send download task heartbeat")
+				continue
+			}
+			if !renewed {
+				cancelDownload(d.getHeartbeatLossCause(ctx, id))
+				return
+			}
+			lastHeartbeatAt = heartbeatAt
+		}
+	}
+}
+
+// getHeartbeatLossCause tells why the heartbeat of a download task could not be renewed. A task that was paused,
+// canceled, failed or deleted is interrupted as usual, any other task has been requeued by the reaper.
+func (d downloadTaskService) getHeartbeatLossCause(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	downloadTask, err := d.downloadTaskRepository.GetDownloadTaskByID(ctx, id)
+	if errors.Is(err, database.ErrDownloadTaskNotFound) {
+		return errDownloadTaskInterrupted
+	}
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to get download task that lost its heartbeat")
+		return errDownloadTaskRequeued
+	}
+
+	switch downloadTask.DownloadStatus {
+	case goload.DownloadStatus_Pending, goload.DownloadStatus_Downloading:
+		return errDownloadTaskRequeued
+	default:
+		return errDownloadTaskInterrupted
+	}
+}
+
+// handleDownloadTaskInterruption finishes a download task that was paused, canceled or deleted while it was
+// executing. A paused task keeps its partial file and checkpoint so that it can be resumed, the file of a canceled or
+// deleted one is dropped.
+func (d downloadTaskService) handleDownloadTaskInterruption(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	metadata map[string]any,
+	checkpoint DownloadCheckpoint,
+) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
 
-	downloadTask.DownloadStatus = goload.DownloadStatus_Failed
-	_, updateDownloadTaskErr := d.downloadTaskRepository.UpdateDownloadTask(ctx, downloadTask)
-	if updateDownloadTaskErr != nil {
-		logger.With(zap.Error(updateDownloadTaskErr)).Warn("failed to update download task status to failed")
+	var currentDownloadStatus goload.DownloadStatus
+	txnErr := d.database.WithTx(func(td *goqu.TxDatabase) error {
+		currentDownloadTask, err := d.downloadTaskRepository.WithDatabase(td).GetDownloadTaskByIDWithXLock(ctx, downloadTask.ID)
+		if err != nil {
+			return err
+		}
+
+		currentDownloadStatus = currentDownloadTask.DownloadStatus
+		if currentDownloadStatus != goload.DownloadStatus_Paused {
+			return nil
+		}
+
+		d.setDownloadCheckpointToMetadata(metadata, checkpoint)
+		encodedMetadata, err := json.Marshal(metadata)
+		if err != nil {
+			return err
+		}
+
+		currentDownloadTask.Metadata = string(encodedMetadata)
+		_, err = d.downloadTaskRepository.WithDatabase(td).UpdateDownloadTask(ctx, currentDownloadTask)
+		return err
+	})
+	if errors.Is(txnErr, database.ErrDownloadTaskNotFound) {
+		d.deleteDownloadTaskFile(ctx, downloadTask.ID)
+		return
 	}
+	if txnErr != nil {
+		logger.With(zap.Error(txnErr)).Warn("failed to save interrupted download task")
+		return
+	}
+
+	if currentDownloadStatus == goload.DownloadStatus_Canceled {
+		d.deleteDownloadTaskFile(ctx, downloadTask.ID)
+	}
+}
+
+// failDownloadTaskAndDeleteFile fails downloadTask for a problem with the file itself, such as going over a quota
+// or a download limit. Retrying would only download the same file again, so the file is dropped.
+func (d downloadTaskService) failDownloadTaskAndDeleteFile(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	metadata map[string]any,
+	limitErr error,
+) {
+	d.deleteDownloadTaskFile(ctx, downloadTask.ID)
+	d.setDownloadCheckpointToMetadata(metadata, DownloadCheckpoint{})
+	if encodedMetadata, err := json.Marshal(metadata); err == nil {
+		downloadTask.Metadata = string(encodedMetadata)
+	}
+	downloadTask.DownloadedBytes = 0
+	d.recordDownloadTaskFailure(ctx, downloadTask, limitErr)
+}
+
+func (d downloadTaskService) getDownloadTaskFileName(id uint64) string {
+	return fmt.Sprintf("download_file_%d", id)
+}
+
+// getDownloadTaskPartFileName is the file that a segmented download writes the segment at index into.
+func (d downloadTaskService) getDownloadTaskPartFileName(id uint64, index int) string {
+	return fmt.Sprintf("%s.part%d", d.getDownloadTaskFileName(id), index)
+}
+
+// deleteDownloadTaskFile deletes the download file of a task, and the part files a segmented download may have left.
+func (d downloadTaskService) deleteDownloadTaskFile(ctx context.Context, id uint64) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	if err := d.fileClient.Delete(ctx, d.getDownloadTaskFileName(id)); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to delete download task file")
+	}
+
+	d.deleteDownloadTaskPartFiles(ctx, id, d.downloadConfig.SegmentCount)
+}
+
+func (d downloadTaskService) deleteDownloadTaskPartFiles(ctx context.Context, id uint64, segmentCount int) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	for i := 0; i < segmentCount; i++ {
+		partFileName := d.getDownloadTaskPartFileName(id, i)
+		if err := d.fileClient.Delete(ctx, partFileName); err != nil {
+			logger.With(zap.Error(err)).With(zap.String("file_name", partFileName)).Warn("failed to delete download task part file")
+		}
+	}
+}
+
+// recordDownloadTaskFailure stores a failed attempt on the download task and either schedules another attempt
+// or marks the task as failed. It reports whether a retry was scheduled.
+func (d downloadTaskService) recordDownloadTaskFailure(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	downloadErr error,
+) bool {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	retryScheduled := d.applyDownloadTaskFailure(ctx, &downloadTask, downloadErr, time.Now())
+
+	updated, err := d.updateDownloadingDownloadTask(ctx, downloadTask)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to record download task failure")
+		return false
+	}
+	if !updated {
+		return false
+	}
+
+	if retryScheduled {
+		logger.
+			With(zap.Uint32("attempt_count", downloadTask.AttemptCount)).
+			With(zap.Time("next_attempt_at", downloadTask.NextAttemptAt.Time)).
+			Info("download task retry scheduled")
+	}
+
+	return retryScheduled
+}
+
+// applyDownloadTaskFailure adds a failed attempt to downloadTask without saving it, setting the task either
+// pending for a retry at the next backoff or failed. It reports whether a retry was scheduled.
+func (d downloadTaskService) applyDownloadTaskFailure(
+	ctx context.Context,
+	downloadTask *database.DownloadTask,
+	downloadErr error,
+	now time.Time,
+) bool {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	var (
+		retryable  = true
+		retryAfter time.Duration
+	)
+
+	var classifiedErr DownloadError
+	if errors.As(downloadErr, &classifiedErr) {
+		retryable = classifiedErr.Retryable
+		retryAfter = classifiedErr.RetryAfter
+	}
+
+	attemptHistory := make([]downloadTaskAttempt, 0)
+	if err := json.Unmarshal([]byte(downloadTask.AttemptHistory), &attemptHistory); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to parse download task attempt history, ignoring it")
+	}
+
+	downloadTask.AttemptCount++
+	attempt := downloadTaskAttempt{
+		Attempt:   downloadTask.AttemptCount,
+		Error:     downloadErr.Error(),
+		Retryable: retryable,
+		FailedAt:  now,
+	}
+
+	retryScheduled := retryable && int(downloadTask.AttemptCount) < d.downloadConfig.Retry.MaxAttempts
+	if retryScheduled {
+		nextAttemptAt := now.Add(max(d.getRetryBackoff(downloadTask.AttemptCount), retryAfter))
+		attempt.NextAttemptAt = &nextAttemptAt
+		downloadTask.DownloadStatus = goload.DownloadStatus_Pending
+		downloadTask.NextAttemptAt = sql.NullTime{Time: nextAttemptAt, Valid: true}
+	} else {
+		downloadTask.DownloadStatus = goload.DownloadStatus_Failed
+		downloadTask.NextAttemptAt = sql.NullTime{}
+		downloadTask.CompletedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	attemptHistory = append(attemptHistory, attempt)
+	encodedAttemptHistory, err := json.Marshal(attemptHistory)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to stringify download task attempt history")
+	} else {
+		downloadTask.AttemptHistory = string(encodedAttemptHistory)
+	}
+	downloadTask.LastError = downloadErr.Error()
+
+	return retryScheduled
+}
+
+// getRetryBackoff doubles the initial backoff for every attempt already made, up to the maximum backoff.
+func (d downloadTaskService) getRetryBackoff(attemptCount uint32) time.Duration {
+	backoff := d.retryInitialBackoff
+	for i := uint32(1); i < attemptCount && backoff < d.retryMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, d.retryMaxBackoff)
 }
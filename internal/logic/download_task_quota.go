@@ -0,0 +1,211 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/dataaccess/database"
+	"goload/internal/generated/grpc/goload"
+	"goload/internal/utils"
+)
+
+var (
+	errPendingDownloadTaskQuotaExceeded = status.Error(codes.ResourceExhausted, "account has too many pending download tasks")
+	errStorageQuotaExceeded             = status.Error(codes.ResourceExhausted, "account has used up its storage quota")
+)
+
+type GetAccountUsageInput struct {
+	OfAccountID uint64
+}
+
+type GetAccountUsageOutput struct {
+	AccountUsage *goload.AccountUsage
+}
+
+// GetAccountUsage implements DownloadTaskService.
+func (d *downloadTaskService) GetAccountUsage(ctx context.Context, input GetAccountUsageInput) (GetAccountUsageOutput, error) {
+	account, err := d.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+	if err != nil {
+		return GetAccountUsageOutput{}, err
+	}
+
+	downloadingTaskCount, err := d.downloadTaskRepository.
+		CountDownloadTasksByOfAccountIDAndStatus(ctx, account.ID, goload.DownloadStatus_Downloading)
+	if err != nil {
+		return GetAccountUsageOutput{}, err
+	}
+
+	pendingTaskCount, err := d.downloadTaskRepository.
+		CountDownloadTasksByOfAccountIDAndStatus(ctx, account.ID, goload.DownloadStatus_Pending)
+	if err != nil {
+		return GetAccountUsageOutput{}, err
+	}
+
+	storedBytes, err := d.downloadTaskRepository.SumDownloadedBytesByOfAccountID(ctx, account.ID)
+	if err != nil {
+		return GetAccountUsageOutput{}, err
+	}
+
+	quota := d.downloadConfig.Quota
+	return GetAccountUsageOutput{
+		AccountUsage: &goload.AccountUsage{
+			DownloadingTaskCount:    downloadingTaskCount,
+			PendingTaskCount:        pendingTaskCount,
+			StoredBytes:             uint64(max(storedBytes, 0)),
+			MaxDownloadingTaskCount: quota.MaxDownloadingTaskCount,
+			MaxPendingTaskCount:     quota.MaxPendingTaskCount,
+			MaxStoredBytes:          uint64(max(quota.MaxStoredBytes, 0)),
+			MaxFileSizeInBytes:      uint64(max(d.getAccountMaxFileSizeInBytes(account), 0)),
+		},
+	}, nil
+}
+
+// getAccountMaxFileSizeInBytes returns the maximum file size of the account, which is its own limit if it has one
+// and the configured one otherwise.
+func (d downloadTaskService) getAccountMaxFileSizeInBytes(account database.Account) int64 {
+	if account.MaxFileSizeInBytes > 0 {
+		return account.MaxFileSizeInBytes
+	}
+
+	return d.downloadConfig.Quota.MaxFileSizeInBytes
+}
+
+// getDownloadLimit returns the limit downloadTask is downloaded with. The task can only lower the maximum file size
+// of its account.
+func (d downloadTaskService) getDownloadLimit(account database.Account, downloadTask database.DownloadTask) DownloadLimit {
+	maxFileSizeInBytes := d.getAccountMaxFileSizeInBytes(account)
+	if downloadTask.MaxFileSizeInBytes > 0 && (maxFileSizeInBytes <= 0 || downloadTask.MaxFileSizeInBytes < maxFileSizeInBytes) {
+		maxFileSizeInBytes = downloadTask.MaxFileSizeInBytes
+	}
+
+	return DownloadLimit{
+		MaxFileSizeInBytes:  maxFileSizeInBytes,
+		AllowedContentTypes: d.downloadConfig.ContentType.AllowedTypes,
+		DeniedContentTypes:  d.downloadConfig.ContentType.DeniedTypes,
+	}
+}
+
+// checkCreateDownloadTaskQuota makes sure the account may queue one more download task. It locks the account as
+// part of td, so that concurrent creations can not overshoot the quota together.
+func (d downloadTaskService) checkCreateDownloadTaskQuota(ctx context.Context, td *goqu.TxDatabase, accountID uint64) error {
+	quota := d.downloadConfig.Quota
+	if quota.MaxPendingTaskCount == 0 && quota.MaxStoredBytes <= 0 {
+		return nil
+	}
+
+	if _, err := d.accountRepository.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID); err != nil {
+		return err
+	}
+
+	if quota.MaxPendingTaskCount > 0 {
+		pendingTaskCount, err := d.downloadTaskRepository.
+			WithDatabase(td).
+			CountDownloadTasksByOfAccountIDAndStatus(ctx, accountID, goload.DownloadStatus_Pending)
+		if err != nil {
+			return err
+		}
+
+		if pendingTaskCount >= quota.MaxPendingTaskCount {
+			return errPendingDownloadTaskQuotaExceeded
+		}
+	}
+
+	if quota.MaxStoredBytes > 0 {
+		storedBytes, err := d.downloadTaskRepository.WithDatabase(td).SumDownloadedBytesByOfAccountID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if storedBytes >= quota.MaxStoredBytes {
+			return errStorageQuotaExceeded
+		}
+	}
+
+	return nil
+}
+
+// isDownloadingQuotaExceeded reports whether the account already has as many downloading tasks as it may have.
+// It locks the account as part of td, so that concurrent workers do not start more downloads than allowed.
+func (d downloadTaskService) isDownloadingQuotaExceeded(ctx context.Context, td *goqu.TxDatabase, accountID uint64) (bool, error) {
+	quota := d.downloadConfig.Quota
+	if quota.MaxDownloadingTaskCount == 0 {
+		return false, nil
+	}
+
+	if _, err := d.accountRepository.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID); err != nil {
+		return false, err
+	}
+
+	downloadingTaskCount, err := d.downloadTaskRepository.
+		WithDatabase(td).
+		CountDownloadTasksByOfAccountIDAndStatus(ctx, accountID, goload.DownloadStatus_Downloading)
+	if err != nil {
+		return false, err
+	}
+
+	return downloadingTaskCount >= quota.MaxDownloadingTaskCount, nil
+}
+
+// getStoredBytesOfOtherDownloadTasks returns how many bytes the account stores outside of downloadTask, or zero
+// if the account has no storage quota.
+func (d downloadTaskService) getStoredBytesOfOtherDownloadTasks(ctx context.Context, accountID uint64, downloadTaskDownloadedBytes int64) (int64, error) {
+	if d.downloadConfig.Quota.MaxStoredBytes <= 0 {
+		return 0, nil
+	}
+
+	storedBytes, err := d.downloadTaskRepository.SumDownloadedBytesByOfAccountID(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+
+	return max(storedBytes-downloadTaskDownloadedBytes, 0), nil
+}
+
+// checkStorageQuotaBeforeDownload returns how many bytes the account stores outside of downloadTask, and
+// errStorageQuotaExceeded if there is no room left for it. The quota is not enforced if the stored bytes can not be
+// looked up, the download goes on as usual.
+func (d downloadTaskService) checkStorageQuotaBeforeDownload(ctx context.Context, downloadTask database.DownloadTask) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	storedBytes, err := d.getStoredBytesOfOtherDownloadTasks(ctx, downloadTask.OfAccountID, downloadTask.DownloadedBytes)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to get stored bytes of account, ignoring storage quota")
+		return 0, nil
+	}
+
+	if quota := d.downloadConfig.Quota; quota.MaxStoredBytes > 0 && storedBytes >= quota.MaxStoredBytes {
+		return storedBytes, errStorageQuotaExceeded
+	}
+
+	return storedBytes, nil
+}
+
+// newDownloadQuotaEnforcer wraps onProgress to stop the download with a quota error as soon as the file turns out
+// to not fit into the remaining storage of the account.
+func (d downloadTaskService) newDownloadQuotaEnforcer(
+	downloadTaskID uint64,
+	storedBytes int64,
+	cancelDownload context.CancelCauseFunc,
+	onProgress DownloadProgressFunc,
+) DownloadProgressFunc {
+	quota := d.downloadConfig.Quota
+
+	return func(ctx context.Context, progress DownloadProgress) {
+		onProgress(ctx, progress)
+
+		fileSize := max(progress.TotalBytes, progress.DownloadedBytes)
+		if quota.MaxStoredBytes <= 0 || storedBytes+fileSize <= quota.MaxStoredBytes {
+			return
+		}
+
+		utils.LoggerWithContext(ctx, d.logger).
+			With(zap.Uint64("id", downloadTaskID)).
+			With(zap.Int64("file_size", fileSize)).
+			Info("download task exceeds the storage quota of its account")
+		cancelDownload(errStorageQuotaExceeded)
+	}
+}
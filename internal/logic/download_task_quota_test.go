@@ -0,0 +1,104 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+)
+
+var errTestSumDownloadedBytesFailed = errors.New("failed to sum downloaded bytes")
+
+// fakeDownloadTaskRepository only implements the methods the tests call, the others panic.
+type fakeDownloadTaskRepository struct {
+	database.DownloadTaskRepository
+	storedBytes    int64
+	sumErr         error
+	sumCalledCount int
+}
+
+func (f *fakeDownloadTaskRepository) SumDownloadedBytesByOfAccountID(ctx context.Context, accountID uint64) (int64, error) {
+	f.sumCalledCount++
+	return f.storedBytes, f.sumErr
+}
+
+func TestCheckStorageQuotaBeforeDownload(t *testing.T) {
+	testCases := []struct {
+		name                string
+		maxStoredBytes      int64
+		storedBytes         int64
+		sumErr              error
+		downloadedBytes     int64
+		expectedStoredBytes int64
+		expectedErr         error
+		expectedSumCalled   bool
+	}{
+		{
+			name:              "no storage quota",
+			storedBytes:       1 << 40,
+			expectedSumCalled: false,
+		},
+		{
+			name:              "failed to sum the stored bytes",
+			maxStoredBytes:    1024,
+			sumErr:            errTestSumDownloadedBytesFailed,
+			expectedSumCalled: true,
+		},
+		{
+			name:                "room left",
+			maxStoredBytes:      1024,
+			storedBytes:         512,
+			expectedStoredBytes: 512,
+			expectedSumCalled:   true,
+		},
+		{
+			name:                "bytes of the task itself are not counted",
+			maxStoredBytes:      1024,
+			storedBytes:         1536,
+			downloadedBytes:     1024,
+			expectedStoredBytes: 512,
+			expectedSumCalled:   true,
+		},
+		{
+			name:                "quota used up",
+			maxStoredBytes:      1024,
+			storedBytes:         1024,
+			expectedStoredBytes: 1024,
+			expectedErr:         errStorageQuotaExceeded,
+			expectedSumCalled:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			downloadTaskRepository := &fakeDownloadTaskRepository{
+				storedBytes: testCase.storedBytes,
+				sumErr:      testCase.sumErr,
+			}
+			service := downloadTaskService{
+				downloadTaskRepository: downloadTaskRepository,
+				downloadConfig:         configs.Download{Quota: configs.Quota{MaxStoredBytes: testCase.maxStoredBytes}},
+				logger:                 zap.NewNop(),
+			}
+
+			storedBytes, err := service.checkStorageQuotaBeforeDownload(context.Background(), database.DownloadTask{
+				ID:              1,
+				OfAccountID:     1,
+				DownloadedBytes: testCase.downloadedBytes,
+			})
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("check returned %v, expected %v", err, testCase.expectedErr)
+			}
+			if storedBytes != testCase.expectedStoredBytes {
+				t.Fatalf("stored bytes are %d, expected %d", storedBytes, testCase.expectedStoredBytes)
+			}
+			if sumCalled := downloadTaskRepository.sumCalledCount > 0; sumCalled != testCase.expectedSumCalled {
+				t.Fatalf("summed the stored bytes: %t, expected %t", sumCalled, testCase.expectedSumCalled)
+			}
+		})
+	}
+}
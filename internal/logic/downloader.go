@@ -2,62 +2,837 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"goload/internal/utils"
 	"io"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
-	HTTPResponseHeaderContentType = "Content-Type"
-	HTTPMetadataKeyContentType    = "content-type"
+	HTTPResponseHeaderContentType   = "Content-Type"
+	HTTPResponseHeaderContentLength = "Content-Length"
+	HTTPResponseHeaderAcceptRanges  = "Accept-Ranges"
+	HTTPResponseHeaderContentRange  = "Content-Range"
+	HTTPResponseHeaderETag          = "ETag"
+	HTTPResponseHeaderLastModified  = "Last-Modified"
+	HTTPResponseHeaderRetryAfter    = "Retry-After"
+	HTTPRequestHeaderRange          = "Range"
+	HTTPRequestHeaderIfRange        = "If-Range"
+	HTTPMetadataKeyContentType      = "content-type"
+
+	httpAcceptRangesBytes             = "bytes"
+	httpResumeRetryDelay              = time.Second
+	downloadCheckpointIntervalInBytes = 8 * 1024 * 1024
+)
+
+var (
+	// ErrDownloadResourceChanged is returned when a resumed download finds that the remote resource is no longer
+	// the one whose bytes were already written, so the caller has to start over from an empty file.
+	ErrDownloadResourceChanged = status.Error(codes.Aborted, "downloaded resource has changed since the last attempt")
 )
 
+// DownloadError is a download failure that knows whether trying again later can succeed.
+type DownloadError struct {
+	Err        error
+	Retryable  bool
+	RetryAfter time.Duration
+}
+
+func (e DownloadError) Error() string {
+	return e.Err.Error()
+}
+
+func (e DownloadError) Unwrap() error {
+	return e.Err
+}
+
+// newHTTPStatusDownloadError classifies an unexpected status code: 408, 429 and 5xx are worth retrying, other
+// client errors are not.
+func newHTTPStatusDownloadError(response *http.Response) DownloadError {
+	retryable := response.StatusCode == http.StatusRequestTimeout ||
+		response.StatusCode == http.StatusTooManyRequests ||
+		response.StatusCode >= http.StatusInternalServerError
+
+	return DownloadError{
+		Err:        fmt.Errorf("unexpected http status code %d", response.StatusCode),
+		Retryable:  retryable,
+		RetryAfter: parseRetryAfter(response.Header.Get(HTTPResponseHeaderRetryAfter)),
+	}
+}
+
+// parseRetryAfter reads a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(retryAfter string) time.Duration {
+	if retryAfter == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.ParseInt(retryAfter, 10, 64); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if retryAt, err := http.ParseTime(retryAfter); err == nil {
+		return max(time.Until(retryAt), 0)
+	}
+
+	return 0
+}
+
+// DownloadCheckpoint is the point a download can be resumed from.
+type DownloadCheckpoint struct {
+	DownloadedBytes int64
+	ETag            string
+	LastModified    string
+	// Segments is set while a segmented download is in progress, DownloadedBytes is then the sum of their
+	// downloaded bytes.
+	Segments []DownloadSegmentCheckpoint
+}
+
+// DownloadSegmentCheckpoint is how far one segment of a segmented download has got. The segment covers the bytes
+// from Start to End of the resource, and the first DownloadedBytes of them are already written into its part.
+type DownloadSegmentCheckpoint struct {
+	Start           int64
+	End             int64
+	DownloadedBytes int64
+}
+
+func (d DownloadSegmentCheckpoint) getSize() int64 {
+	return d.End - d.Start + 1
+}
+
+// DownloadCheckpointFunc is called periodically while downloading so that the progress can be persisted.
+type DownloadCheckpointFunc func(ctx context.Context, checkpoint DownloadCheckpoint)
+
 type Downloader interface {
-	Download(ctx context.Context, writer io.Writer) (map[string]any, error)
+	// Download writes the resource into writer. If checkpoint has downloaded bytes, writer must already contain
+	// them and the download continues right after them.
+	Download(
+		ctx context.Context,
+		writer io.Writer,
+		checkpoint DownloadCheckpoint,
+		onCheckpoint DownloadCheckpointFunc,
+		onProgress DownloadProgressFunc,
+	) (map[string]any, DownloadCheckpoint, error)
+}
+
+// DownloadProbe describes a resource before it is downloaded.
+type DownloadProbe struct {
+	Size         int64
+	SegmentCount int
+	ContentType  string
+	ETag         string
+	LastModified string
+}
+
+// SegmentWriterFunc opens the writer of the segment at index, which receives the bytes of that segment only. The
+// writer keeps the first offset bytes already written into the segment and continues right after them, unless it
+// can not, in which case it returns the lower offset it actually continues from.
+type SegmentWriterFunc func(ctx context.Context, index int, offset int64) (io.WriteCloser, int64, error)
+
+// SegmentedDownloader is implemented by downloaders that can fetch several parts of a resource in parallel.
+type SegmentedDownloader interface {
+	// Probe inspects the resource without downloading it. A SegmentCount lower than 2 means the resource should
+	// be downloaded with Download instead.
+	Probe(ctx context.Context) (DownloadProbe, error)
+	// DownloadSegments writes every segment of the probed resource into its own writer, in the order of the file.
+	// If checkpoint has segments, each of them continues from its downloaded bytes, and ErrDownloadResourceChanged
+	// is returned when the probed resource is no longer the one they were downloaded from.
+	DownloadSegments(
+		ctx context.Context,
+		openSegmentWriter SegmentWriterFunc,
+		probe DownloadProbe,
+		checkpoint DownloadCheckpoint,
+		onCheckpoint DownloadCheckpointFunc,
+		onProgress DownloadProgressFunc,
+	) (map[string]any, DownloadCheckpoint, error)
 }
 
 type httpDownloader struct {
-	url    string
-	logger *zap.Logger
+	url                   string
+	client                *http.Client
+	limit                 DownloadLimit
+	resumeMaxAttempts     int
+	segmentCount          int
+	minSegmentSizeInBytes int64
+	logger                *zap.Logger
 }
 
 func NewHttpDownloader(
 	url string,
+	client *http.Client,
+	limit DownloadLimit,
+	resumeMaxAttempts int,
+	segmentCount int,
+	minSegmentSizeInBytes int64,
 	logger *zap.Logger,
 ) Downloader {
 	return &httpDownloader{
-		url:    url,
-		logger: logger,
+		url:                   url,
+		client:                client,
+		limit:                 limit,
+		resumeMaxAttempts:     resumeMaxAttempts,
+		segmentCount:          segmentCount,
+		minSegmentSizeInBytes: minSegmentSizeInBytes,
+		logger:                logger,
 	}
 }
 
+type checkpointWriter struct {
+	ctx                   context.Context
+	writer                io.Writer
+	checkpoint            *DownloadCheckpoint
+	lastCheckpointedBytes int64
+	onCheckpoint          DownloadCheckpointFunc
+}
+
+func (c *checkpointWriter) Write(p []byte) (int, error) {
+	writtenByteCount, err := c.writer.Write(p)
+	c.checkpoint.DownloadedBytes += int64(writtenByteCount)
+
+	if c.onCheckpoint != nil && c.checkpoint.DownloadedBytes-c.lastCheckpointedBytes >= downloadCheckpointIntervalInBytes {
+		c.onCheckpoint(c.ctx, *c.checkpoint)
+		c.lastCheckpointedBytes = c.checkpoint.DownloadedBytes
+	}
+
+	return writtenByteCount, err
+}
+
+// segmentCheckpointer keeps the checkpoint of a segmented download, whose segments are written concurrently, and
+// passes a copy of it to onCheckpoint every downloadCheckpointIntervalInBytes. It also reports the progress.
+//
+// Both callbacks save the download task, so they run one at a time under callbackMutex, on copies taken under mutex.
+// A segment that finds them already running does not wait, the bytes it counted are reported by the next run.
+type segmentCheckpointer struct {
+	mutex                 sync.Mutex
+	checkpoint            DownloadCheckpoint
+	lastCheckpointedBytes int64
+	unreportedBytes       int64
+	callbackMutex         sync.Mutex
+	onCheckpoint          DownloadCheckpointFunc
+	progressReporter      *downloadProgressReporter
+}
+
+func (s *segmentCheckpointer) GetSegment(index int) DownloadSegmentCheckpoint {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.checkpoint.Segments[index]
+}
+
+// SetSegmentDownloadedBytes moves the segment at index back to downloadedBytes, when its part could not keep more.
+func (s *segmentCheckpointer) SetSegmentDownloadedBytes(ctx context.Context, index int, downloadedBytes int64) {
+	s.mutex.Lock()
+	s.add(index, downloadedBytes-s.checkpoint.Segments[index].DownloadedBytes)
+	s.mutex.Unlock()
+
+	s.runCallbacksIfIdle(ctx)
+}
+
+func (s *segmentCheckpointer) Add(ctx context.Context, index int, byteCount int64) {
+	s.mutex.Lock()
+	s.add(index, byteCount)
+	s.mutex.Unlock()
+
+	s.runCallbacksIfIdle(ctx)
+}
+
+func (s *segmentCheckpointer) add(index int, byteCount int64) {
+	s.checkpoint.Segments[index].DownloadedBytes += byteCount
+	s.checkpoint.DownloadedBytes += byteCount
+	s.unreportedBytes += byteCount
+}
+
+// Save passes the checkpoint to onCheckpoint right away, for example after a segment failed.
+func (s *segmentCheckpointer) Save(ctx context.Context) {
+	s.callbackMutex.Lock()
+	defer s.callbackMutex.Unlock()
+
+	s.runCallbacks(ctx, true)
+}
+
+// Report reports the progress right away, once every segment is downloaded.
+func (s *segmentCheckpointer) Report(ctx context.Context) {
+	s.callbackMutex.Lock()
+	defer s.callbackMutex.Unlock()
+
+	s.runCallbacks(ctx, false)
+	s.progressReporter.Report(ctx)
+}
+
+func (s *segmentCheckpointer) runCallbacksIfIdle(ctx context.Context) {
+	if !s.callbackMutex.TryLock() {
+		return
+	}
+	defer s.callbackMutex.Unlock()
+
+	s.runCallbacks(ctx, false)
+}
+
+// runCallbacks must be called with callbackMutex held. The checkpoint is copied before the callbacks run, so that the
+// segments keep downloading while it is being saved.
+func (s *segmentCheckpointer) runCallbacks(ctx context.Context, forceCheckpoint bool) {
+	s.mutex.Lock()
+	unreportedBytes := s.unreportedBytes
+	s.unreportedBytes = 0
+	checkpointDue := forceCheckpoint || s.checkpoint.DownloadedBytes-s.lastCheckpointedBytes >= downloadCheckpointIntervalInBytes
+	var checkpoint DownloadCheckpoint
+	if checkpointDue {
+		s.lastCheckpointedBytes = s.checkpoint.DownloadedBytes
+		checkpoint = s.getCheckpoint()
+	}
+	s.mutex.Unlock()
+
+	s.progressReporter.Add(ctx, unreportedBytes)
+	if checkpointDue && s.onCheckpoint != nil {
+		s.onCheckpoint(ctx, checkpoint)
+	}
+}
+
+// GetCheckpoint returns a copy of the checkpoint, which the segments that are still downloading do not change.
+func (s *segmentCheckpointer) GetCheckpoint() DownloadCheckpoint {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.getCheckpoint()
+}
+
+func (s *segmentCheckpointer) getCheckpoint() DownloadCheckpoint {
+	checkpoint := s.checkpoint
+	checkpoint.Segments = slices.Clone(s.checkpoint.Segments)
+
+	return checkpoint
+}
+
+type segmentCheckpointWriter struct {
+	ctx          context.Context
+	writer       io.Writer
+	checkpointer *segmentCheckpointer
+	index        int
+}
+
+func (s segmentCheckpointWriter) Write(p []byte) (int, error) {
+	writtenByteCount, err := s.writer.Write(p)
+	s.checkpointer.Add(s.ctx, s.index, int64(writtenByteCount))
+
+	return writtenByteCount, err
+}
+
+func (h httpDownloader) isResumable(checkpoint DownloadCheckpoint, acceptRanges bool) bool {
+	return acceptRanges && (checkpoint.ETag != "" || checkpoint.LastModified != "")
+}
+
+func (h httpDownloader) setIfRangeHeader(request *http.Request, eTag string, lastModified string) {
+	// With If-Range, a server whose resource has changed answers with the full content instead of a range.
+	if eTag != "" && !strings.HasPrefix(eTag, "W/") {
+		request.Header.Set(HTTPRequestHeaderIfRange, eTag)
+	} else if lastModified != "" {
+		request.Header.Set(HTTPRequestHeaderIfRange, lastModified)
+	}
+}
+
+func (h httpDownloader) newRequest(ctx context.Context, checkpoint DownloadCheckpoint) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if checkpoint.DownloadedBytes > 0 {
+		request.Header.Set(HTTPRequestHeaderRange, fmt.Sprintf("bytes=%d-", checkpoint.DownloadedBytes))
+		h.setIfRangeHeader(request, checkpoint.ETag, checkpoint.LastModified)
+	}
+
+	return request, nil
+}
+
+// isSameResource reports whether a partial response continues the bytes described by checkpoint.
+func (h httpDownloader) isSameResource(response *http.Response, checkpoint DownloadCheckpoint) bool {
+	if response.StatusCode != http.StatusPartialContent {
+		return false
+	}
+
+	if checkpoint.ETag != "" && response.Header.Get(HTTPResponseHeaderETag) != checkpoint.ETag {
+		return false
+	}
+
+	if checkpoint.ETag == "" && checkpoint.LastModified != "" &&
+		response.Header.Get(HTTPResponseHeaderLastModified) != checkpoint.LastModified {
+		return false
+	}
+
+	return strings.HasPrefix(
+		response.Header.Get(HTTPResponseHeaderContentRange),
+		fmt.Sprintf("bytes %d-", checkpoint.DownloadedBytes),
+	)
+}
+
 // Download implements Downloader.
-func (h *httpDownloader) Download(ctx context.Context, writer io.Writer) (map[string]any, error) {
-	logger := utils.LoggerWithContext(ctx, h.logger)
+func (h *httpDownloader) Download(
+	ctx context.Context,
+	writer io.Writer,
+	checkpoint DownloadCheckpoint,
+	onCheckpoint DownloadCheckpointFunc,
+	onProgress DownloadProgressFunc,
+) (map[string]any, DownloadCheckpoint, error) {
+	logger := utils.LoggerWithContext(ctx, h.logger).With(zap.String("url", h.url))
+	progressReporter := newDownloadProgressReporter(checkpoint.DownloadedBytes, onProgress)
+
+	var (
+		contentType  string
+		acceptRanges = checkpoint.DownloadedBytes > 0
+		lastErr      error
+	)
+
+	for attempt := 0; attempt <= h.resumeMaxAttempts; attempt++ {
+		if attempt > 0 {
+			if !h.isResumable(checkpoint, acceptRanges) {
+				break
+			}
+
+			logger.
+				With(zap.Int("attempt", attempt)).
+				With(zap.Int64("downloaded_bytes", checkpoint.DownloadedBytes)).
+				Info("resuming interrupted download")
+
+			select {
+			case <-ctx.Done():
+				return nil, checkpoint, ctx.Err()
+			case <-time.After(httpResumeRetryDelay):
+			}
+		}
+
+		request, err := h.newRequest(ctx, checkpoint)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to create new http request")
+			return nil, checkpoint, err
+		}
+
+		response, err := h.client.Do(request)
+		if egressErr, ok := newEgressDownloadError(err); ok {
+			logger.With(zap.Error(err)).Warn("download url is blocked by the egress policy")
+			return nil, checkpoint, egressErr
+		}
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to download from url")
+			lastErr = err
+			continue
+		}
+
+		if checkpoint.DownloadedBytes > 0 && !h.isSameResource(response, checkpoint) {
+			response.Body.Close()
+			logger.With(zap.Int("status_code", response.StatusCode)).Warn("resource can not be resumed")
+			return nil, DownloadCheckpoint{}, ErrDownloadResourceChanged
+		}
+
+		if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusPartialContent {
+			response.Body.Close()
+			logger.With(zap.Int("status_code", response.StatusCode)).Error("unexpected http status code")
+			return nil, checkpoint, newHTTPStatusDownloadError(response)
+		}
+
+		if err = h.limit.checkResponse(response, checkpoint.DownloadedBytes); err != nil {
+			response.Body.Close()
+			logger.With(zap.Error(err)).Warn("download exceeds the download limit")
+			return nil, checkpoint, err
+		}
+
+		body, err := h.limit.newLimitedBody(response.Body, checkpoint.DownloadedBytes)
+		if err != nil {
+			response.Body.Close()
+			if isDownloadLimitError(err) {
+				logger.With(zap.Error(err)).Warn("download exceeds the download limit")
+				return nil, checkpoint, err
+			}
+
+			logger.With(zap.Error(err)).Error("failed to read downloaded file")
+			lastErr = err
+			continue
+		}
+
+		if checkpoint.DownloadedBytes == 0 {
+			checkpoint.ETag = response.Header.Get(HTTPResponseHeaderETag)
+			checkpoint.LastModified = response.Header.Get(HTTPResponseHeaderLastModified)
+		}
+		acceptRanges = response.Header.Get(HTTPResponseHeaderAcceptRanges) == httpAcceptRangesBytes ||
+			response.StatusCode == http.StatusPartialContent
+		if contentType == "" {
+			contentType = response.Header.Get(HTTPResponseHeaderContentType)
+		}
+		if response.ContentLength >= 0 {
+			progressReporter.SetTotalBytes(checkpoint.DownloadedBytes + response.ContentLength)
+		}
+
+		_, err = io.Copy(&checkpointWriter{
+			ctx: ctx,
+			writer: progressWriter{
+				ctx:              ctx,
+				writer:           writer,
+				progressReporter: progressReporter,
+			},
+			checkpoint:            &checkpoint,
+			lastCheckpointedBytes: checkpoint.DownloadedBytes,
+			onCheckpoint:          onCheckpoint,
+		}, body)
+		response.Body.Close()
+		if err == nil {
+			progressReporter.Report(ctx)
+			return map[string]any{
+				HTTPMetadataKeyContentType: contentType,
+			}, checkpoint, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, checkpoint, ctx.Err()
+		}
+
+		if isDownloadLimitError(err) {
+			logger.With(zap.Error(err)).Warn("download exceeds the download limit")
+			return nil, checkpoint, err
+		}
+
+		logger.With(zap.Error(err)).Error("failed to write downloaded file")
+		lastErr = err
+		if onCheckpoint != nil {
+			onCheckpoint(ctx, checkpoint)
+		}
+	}
+
+	if lastErr == nil {
+		lastErr = errors.New("download failed")
+	}
+
+	return nil, checkpoint, lastErr
+}
+
+// Probe implements SegmentedDownloader.
+func (h *httpDownloader) Probe(ctx context.Context) (DownloadProbe, error) {
+	logger := utils.LoggerWithContext(ctx, h.logger).With(zap.String("url", h.url))
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, h.url, http.NoBody)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create new http request")
+		return DownloadProbe{}, err
+	}
+
+	response, err := h.client.Do(request)
+	if egressErr, ok := newEgressDownloadError(err); ok {
+		logger.With(zap.Error(err)).Warn("download url is blocked by the egress policy")
+		return DownloadProbe{}, egressErr
+	}
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to probe url")
+		return DownloadProbe{}, err
+	}
+	defer response.Body.Close()
+
+	probe := DownloadProbe{
+		Size:         -1,
+		SegmentCount: 1,
+		ContentType:  response.Header.Get(HTTPResponseHeaderContentType),
+		ETag:         response.Header.Get(HTTPResponseHeaderETag),
+		LastModified: response.Header.Get(HTTPResponseHeaderLastModified),
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return probe, nil
+	}
+
+	size, err := strconv.ParseInt(response.Header.Get(HTTPResponseHeaderContentLength), 10, 64)
+	if err != nil || size <= 0 {
+		return probe, nil
+	}
+	probe.Size = size
+
+	if response.Header.Get(HTTPResponseHeaderAcceptRanges) != httpAcceptRangesBytes || h.minSegmentSizeInBytes <= 0 {
+		return probe, nil
+	}
+
+	probe.SegmentCount = int(min(int64(h.segmentCount), size/h.minSegmentSizeInBytes))
+	probe.SegmentCount = max(probe.SegmentCount, 1)
+
+	return probe, nil
+}
+
+// isSameProbedResource reports whether the segments of checkpoint were downloaded from the probed resource. Without
+// a validator to compare, it can not tell, so it reports false.
+func (h httpDownloader) isSameProbedResource(probe DownloadProbe, checkpoint DownloadCheckpoint) bool {
+	lastSegment := checkpoint.Segments[len(checkpoint.Segments)-1]
+	if probe.Size != lastSegment.End+1 {
+		return false
+	}
+
+	if checkpoint.ETag != "" {
+		return probe.ETag == checkpoint.ETag
+	}
+
+	return checkpoint.LastModified != "" && probe.LastModified == checkpoint.LastModified
+}
+
+// splitSegments divides the probed resource into probe.SegmentCount segments of the same size, the last one also
+// takes the remaining bytes.
+func (h httpDownloader) splitSegments(probe DownloadProbe) []DownloadSegmentCheckpoint {
+	segmentList := make([]DownloadSegmentCheckpoint, probe.SegmentCount)
+	segmentSize := probe.Size / int64(probe.SegmentCount)
+	for i := range segmentList {
+		segmentList[i].Start = int64(i) * segmentSize
+		segmentList[i].End = segmentList[i].Start + segmentSize - 1
+	}
+	segmentList[len(segmentList)-1].End = probe.Size - 1
+
+	return segmentList
+}
+
+// DownloadSegments implements SegmentedDownloader.
+func (h *httpDownloader) DownloadSegments(
+	ctx context.Context,
+	openSegmentWriter SegmentWriterFunc,
+	probe DownloadProbe,
+	checkpoint DownloadCheckpoint,
+	onCheckpoint DownloadCheckpointFunc,
+	onProgress DownloadProgressFunc,
+) (map[string]any, DownloadCheckpoint, error) {
+	logger := utils.LoggerWithContext(ctx, h.logger).
+		With(zap.String("url", h.url)).
+		With(zap.Int64("size", probe.Size)).
+		With(zap.Int("segment_count", probe.SegmentCount))
+
+	if err := h.limit.checkFileSize(probe.Size); err != nil {
+		logger.With(zap.Error(err)).Warn("download exceeds the download limit")
+		return nil, DownloadCheckpoint{}, err
+	}
+
+	if err := h.limit.checkContentType(probe.ContentType, false); err != nil {
+		logger.With(zap.Error(err)).Warn("download exceeds the download limit")
+		return nil, DownloadCheckpoint{}, err
+	}
+
+	if len(checkpoint.Segments) == 0 {
+		checkpoint = DownloadCheckpoint{
+			ETag:         probe.ETag,
+			LastModified: probe.LastModified,
+			Segments:     h.splitSegments(probe),
+		}
+	} else if !h.isSameProbedResource(probe, checkpoint) {
+		logger.Warn("segmented download can not be resumed")
+		return nil, DownloadCheckpoint{}, ErrDownloadResourceChanged
+	}
+
+	segmentCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	progressReporter := newDownloadProgressReporter(checkpoint.DownloadedBytes, onProgress)
+	progressReporter.SetTotalBytes(probe.Size)
+	checkpointer := &segmentCheckpointer{
+		checkpoint:            checkpoint,
+		lastCheckpointedBytes: checkpoint.DownloadedBytes,
+		onCheckpoint:          onCheckpoint,
+		progressReporter:      progressReporter,
+	}
+
+	var (
+		waitGroup sync.WaitGroup
+		errOnce   sync.Once
+		firstErr  error
+	)
+
+	for i, segment := range checkpoint.Segments {
+		if segment.DownloadedBytes >= segment.getSize() {
+			continue
+		}
+
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			if err := h.downloadSegmentWithRetry(segmentCtx, openSegmentWriter, probe, i, checkpointer); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}()
+	}
+
+	waitGroup.Wait()
+	if firstErr != nil {
+		if ctx.Err() != nil {
+			return nil, checkpointer.GetCheckpoint(), ctx.Err()
+		}
+
+		logger.With(zap.Error(firstErr)).Error("failed to download segments")
+		if errors.Is(firstErr, ErrDownloadResourceChanged) {
+			return nil, DownloadCheckpoint{}, firstErr
+		}
+
+		return nil, checkpointer.GetCheckpoint(), firstErr
+	}
+
+	checkpointer.Report(ctx)
+
+	return map[string]any{
+		HTTPMetadataKeyContentType: probe.ContentType,
+	}, checkpointer.GetCheckpoint(), nil
+}
+
+// downloadSegmentWithRetry downloads the segment at index, trying again from where the previous attempt stopped as
+// long as the failure is not one that another attempt would run into as well.
+func (h httpDownloader) downloadSegmentWithRetry(
+	ctx context.Context,
+	openSegmentWriter SegmentWriterFunc,
+	probe DownloadProbe,
+	index int,
+	checkpointer *segmentCheckpointer,
+) error {
+	logger := utils.LoggerWithContext(ctx, h.logger).
+		With(zap.String("url", h.url)).
+		With(zap.Int("segment_index", index))
+
+	var lastErr error
+	for attempt := 0; attempt <= h.resumeMaxAttempts; attempt++ {
+		if attempt > 0 {
+			logger.
+				With(zap.Int("attempt", attempt)).
+				With(zap.Int64("downloaded_bytes", checkpointer.GetSegment(index).DownloadedBytes)).
+				Info("resuming interrupted segment")
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(httpResumeRetryDelay):
+			}
+		}
+
+		err := h.downloadSegment(ctx, openSegmentWriter, probe, index, checkpointer)
+		if err == nil {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var downloadErr DownloadError
+		if errors.Is(err, ErrDownloadResourceChanged) || (errors.As(err, &downloadErr) && !downloadErr.Retryable) {
+			return err
+		}
+
+		lastErr = err
+		checkpointer.Save(ctx)
+	}
+
+	return lastErr
+}
+
+// downloadSegment opens the writer of the segment at index and downloads the rest of the segment into it.
+func (h httpDownloader) downloadSegment(
+	ctx context.Context,
+	openSegmentWriter SegmentWriterFunc,
+	probe DownloadProbe,
+	index int,
+	checkpointer *segmentCheckpointer,
+) error {
+	segment := checkpointer.GetSegment(index)
+	logger := utils.LoggerWithContext(ctx, h.logger).
+		With(zap.String("url", h.url)).
+		With(zap.Int64("start", segment.Start)).
+		With(zap.Int64("end", segment.End))
+
+	segmentWriteCloser, downloadedBytes, err := openSegmentWriter(ctx, index, segment.DownloadedBytes)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to open segment writer")
+		return err
+	}
+
+	if downloadedBytes != segment.DownloadedBytes {
+		logger.
+			With(zap.Int64("downloaded_bytes", segment.DownloadedBytes)).
+			Info("segment can not be resumed, restarting it from its beginning")
+		checkpointer.SetSegmentDownloadedBytes(ctx, index, downloadedBytes)
+		segment.DownloadedBytes = downloadedBytes
+	}
+
+	err = h.copySegment(ctx, segmentWriteCloser, probe, index, segment, checkpointer)
+	if closeErr := segmentWriteCloser.Close(); closeErr != nil && err == nil {
+		logger.With(zap.Error(closeErr)).Error("failed to close segment writer")
+		err = closeErr
+	}
+
+	return err
+}
+
+func (h httpDownloader) copySegment(
+	ctx context.Context,
+	writer io.Writer,
+	probe DownloadProbe,
+	index int,
+	segment DownloadSegmentCheckpoint,
+	checkpointer *segmentCheckpointer,
+) error {
+	start := segment.Start + segment.DownloadedBytes
+	logger := utils.LoggerWithContext(ctx, h.logger).
+		With(zap.String("url", h.url)).
+		With(zap.Int64("start", start)).
+		With(zap.Int64("end", segment.End))
 
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url, http.NoBody)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to create new http request")
-		return nil, err
+		return err
 	}
 
-	response, err := http.DefaultClient.Do(request)
+	request.Header.Set(HTTPRequestHeaderRange, fmt.Sprintf("bytes=%d-%d", start, segment.End))
+	h.setIfRangeHeader(request, probe.ETag, probe.LastModified)
+
+	response, err := h.client.Do(request)
+	if egressErr, ok := newEgressDownloadError(err); ok {
+		logger.With(zap.Error(err)).Warn("download url is blocked by the egress policy")
+		return egressErr
+	}
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to download from url")
-		return nil, err
+		logger.With(zap.Error(err)).Error("failed to download segment")
+		return err
 	}
 	defer response.Body.Close()
 
-	_, err = io.Copy(writer, response.Body)
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusPartialContent {
+		logger.With(zap.Int("status_code", response.StatusCode)).Error("unexpected http status code")
+		return newHTTPStatusDownloadError(response)
+	}
+
+	// A full response means that If-Range did not match, the resource has changed since it was probed.
+	if response.StatusCode != http.StatusPartialContent ||
+		!strings.HasPrefix(response.Header.Get(HTTPResponseHeaderContentRange), fmt.Sprintf("bytes %d-%d/", start, segment.End)) {
+		logger.With(zap.Int("status_code", response.StatusCode)).Warn("server did not return the requested segment")
+		return ErrDownloadResourceChanged
+	}
+
+	// The size of the file is already checked against the probe, this only sniffs the type of the first segment.
+	body, err := h.limit.newLimitedBody(response.Body, start)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to write downloaded file")
-		return nil, err
+		logger.With(zap.Error(err)).Warn("failed to check downloaded segment")
+		return err
 	}
-	metadata := map[string]any{
-		HTTPMetadataKeyContentType: response.Header.Get(HTTPResponseHeaderContentType),
+
+	_, err = io.CopyN(segmentCheckpointWriter{
+		ctx:          ctx,
+		writer:       writer,
+		checkpointer: checkpointer,
+		index:        index,
+	}, body, segment.End-start+1)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.With(zap.Error(err)).Error("failed to write downloaded segment")
+		}
+		return err
 	}
 
-	return metadata, nil
+	return nil
 }
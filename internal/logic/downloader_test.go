@@ -0,0 +1,143 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// testSegmentWriter keeps the bytes of one segment, and tells onWrite about every write.
+type testSegmentWriter struct {
+	mutex   *sync.Mutex
+	buffer  *bytes.Buffer
+	onWrite func()
+}
+
+func (t testSegmentWriter) Write(p []byte) (int, error) {
+	t.onWrite()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.buffer.Write(p)
+}
+
+func (t testSegmentWriter) Close() error {
+	return nil
+}
+
+func TestHttpDownloaderDownloadSegmentsDoesNotWaitForCheckpoints(t *testing.T) {
+	const segmentCount = 4
+
+	data := make([]byte, segmentCount*downloadCheckpointIntervalInBytes)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"test"`)
+		http.ServeContent(w, r, "file", time.Time{}, bytes.NewReader(data))
+	}))
+	t.Cleanup(server.Close)
+
+	downloader := NewHttpDownloader(server.URL, server.Client(), DownloadLimit{}, 1, segmentCount, 1024, zap.NewNop())
+	segmentedDownloader := downloader.(SegmentedDownloader)
+
+	probe, err := segmentedDownloader.Probe(context.Background())
+	if err != nil {
+		t.Fatalf("failed to probe: %v", err)
+	}
+	if probe.SegmentCount != segmentCount {
+		t.Fatalf("probed %d segments, expected %d", probe.SegmentCount, segmentCount)
+	}
+
+	var (
+		bufferMutex   sync.Mutex
+		bufferList    = make([]*bytes.Buffer, segmentCount)
+		blocking      atomic.Bool
+		blockedWrites atomic.Int64
+		writtenOnce   sync.Once
+		writtenWhile  = make(chan struct{})
+		blockedOnce   sync.Once
+		checkpointMux sync.Mutex
+		checkpointed  []int64
+	)
+	for i := range bufferList {
+		bufferList[i] = new(bytes.Buffer)
+	}
+
+	openSegmentWriter := func(ctx context.Context, index int, offset int64) (io.WriteCloser, int64, error) {
+		return testSegmentWriter{
+			mutex:  &bufferMutex,
+			buffer: bufferList[index],
+			onWrite: func() {
+				// A segment writes once more before it would wait on the checkpoint, so a single write per segment
+				// does not show that the segments keep going.
+				if blocking.Load() && blockedWrites.Add(1) > 2*segmentCount {
+					writtenOnce.Do(func() { close(writtenWhile) })
+				}
+			},
+		}, offset, nil
+	}
+
+	// The first checkpoint is saved slowly, the other segments have to keep writing meanwhile.
+	onCheckpoint := func(ctx context.Context, checkpoint DownloadCheckpoint) {
+		blockedOnce.Do(func() {
+			blocking.Store(true)
+			select {
+			case <-writtenWhile:
+			case <-time.After(10 * time.Second):
+				t.Error("no segment was written while a checkpoint was being saved")
+			}
+			blocking.Store(false)
+		})
+
+		checkpointMux.Lock()
+		defer checkpointMux.Unlock()
+		checkpointed = append(checkpointed, checkpoint.DownloadedBytes)
+	}
+
+	var lastProgress DownloadProgress
+	onProgress := func(ctx context.Context, progress DownloadProgress) {
+		lastProgress = progress
+	}
+
+	_, checkpoint, err := segmentedDownloader.DownloadSegments(
+		context.Background(), openSegmentWriter, probe, DownloadCheckpoint{
+			ETag:     probe.ETag,
+			Segments: downloader.(*httpDownloader).splitSegments(probe),
+		}, onCheckpoint, onProgress)
+	if err != nil {
+		t.Fatalf("failed to download segments: %v", err)
+	}
+
+	var downloaded []byte
+	for _, buffer := range bufferList {
+		downloaded = append(downloaded, buffer.Bytes()...)
+	}
+	if !bytes.Equal(downloaded, data) {
+		t.Fatalf("downloaded %d bytes, expected the %d bytes of the file", len(downloaded), len(data))
+	}
+	if checkpoint.DownloadedBytes != int64(len(data)) {
+		t.Fatalf("checkpoint has %d downloaded bytes, expected %d", checkpoint.DownloadedBytes, len(data))
+	}
+	if lastProgress.DownloadedBytes != int64(len(data)) {
+		t.Fatalf("last progress has %d downloaded bytes, expected %d", lastProgress.DownloadedBytes, len(data))
+	}
+	if len(checkpointed) == 0 {
+		t.Fatal("no checkpoint was saved")
+	}
+	for i := 1; i < len(checkpointed); i++ {
+		if checkpointed[i] < checkpointed[i-1] {
+			t.Fatalf("checkpoints went back from %d to %d bytes", checkpointed[i-1], checkpointed[i])
+		}
+	}
+}
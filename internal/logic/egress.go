@@ -0,0 +1,281 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/configs"
+)
+
+const (
+	egressDialTimeout   = 30 * time.Second
+	egressDialKeepAlive = 30 * time.Second
+)
+
+var (
+	errDownloadURLNotAllowed    = status.Error(codes.InvalidArgument, "download url is not allowed")
+	errDownloadAddressBlocked   = status.Error(codes.PermissionDenied, "download address is blocked")
+	errTooManyDownloadRedirects = status.Error(codes.InvalidArgument, "download url redirects too many times")
+)
+
+// egressAlwaysBlockedPrefixList are the ranges that never lead to a public download: private networks, loopback,
+// link-local including cloud metadata endpoints, and the other special purpose ranges.
+var egressAlwaysBlockedPrefixList = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::/128"),
+	netip.MustParsePrefix("::1/128"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+	netip.MustParsePrefix("fc00::/7"),
+	netip.MustParsePrefix("fe80::/10"),
+	netip.MustParsePrefix("ff00::/8"),
+}
+
+var egressDefaultPorts = map[string]uint16{
+	"http":  80,
+	"https": 443,
+}
+
+// egressPolicy decides which urls and addresses download tasks may connect to.
+type egressPolicy struct {
+	allowedSchemes    map[string]struct{}
+	blockedPrefixList []netip.Prefix
+	blockedPorts      map[uint16]struct{}
+	allowedPrefixList []netip.Prefix
+	allowedHosts      map[string]struct{}
+	maxRedirects      int
+}
+
+func newEgressPolicy(egressConfig configs.Egress) (*egressPolicy, error) {
+	policy := &egressPolicy{
+		allowedSchemes:    make(map[string]struct{}),
+		blockedPrefixList: append([]netip.Prefix{}, egressAlwaysBlockedPrefixList...),
+		blockedPorts:      make(map[uint16]struct{}),
+		allowedPrefixList: make([]netip.Prefix, 0, len(egressConfig.AllowedCIDRs)),
+		allowedHosts:      make(map[string]struct{}),
+		maxRedirects:      egressConfig.MaxRedirects,
+	}
+
+	for _, scheme := range egressConfig.AllowedSchemes {
+		if _, ok := egressDefaultPorts[strings.ToLower(scheme)]; !ok {
+			return nil, fmt.Errorf("unsupported egress scheme %q", scheme)
+		}
+
+		policy.allowedSchemes[strings.ToLower(scheme)] = struct{}{}
+	}
+
+	for _, cidr := range egressConfig.BlockedCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blocked egress cidr %q: %w", cidr, err)
+		}
+
+		policy.blockedPrefixList = append(policy.blockedPrefixList, prefix.Masked())
+	}
+
+	for _, port := range egressConfig.BlockedPorts {
+		policy.blockedPorts[port] = struct{}{}
+	}
+
+	for _, cidr := range egressConfig.AllowedCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed egress cidr %q: %w", cidr, err)
+		}
+
+		policy.allowedPrefixList = append(policy.allowedPrefixList, prefix.Masked())
+	}
+
+	for _, host := range egressConfig.AllowedHosts {
+		policy.allowedHosts[normalizeEgressHost(host)] = struct{}{}
+	}
+
+	return policy, nil
+}
+
+func normalizeEgressHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+func (e egressPolicy) isHostAllowed(host string) bool {
+	_, ok := e.allowedHosts[normalizeEgressHost(host)]
+	return ok
+}
+
+func (e egressPolicy) isAddrAllowed(addr netip.Addr) bool {
+	// An IPv4-mapped IPv6 address reaches the IPv4 host, so it is checked against the IPv4 ranges.
+	addr = addr.Unmap()
+	for _, prefix := range e.allowedPrefixList {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	for _, prefix := range e.blockedPrefixList {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e egressPolicy) isPortAllowed(port uint16) bool {
+	_, blocked := e.blockedPorts[port]
+	return !blocked
+}
+
+// checkURL rejects a url early if its scheme, port or literal address is blocked. Host names are only checked
+// once they are resolved, when connecting.
+func (e egressPolicy) checkURL(rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return errDownloadURLNotAllowed
+	}
+
+	scheme := strings.ToLower(parsedURL.Scheme)
+	if _, ok := e.allowedSchemes[scheme]; !ok {
+		return errDownloadURLNotAllowed
+	}
+
+	host := parsedURL.Hostname()
+	if host == "" {
+		return errDownloadURLNotAllowed
+	}
+
+	port := egressDefaultPorts[scheme]
+	if parsedURL.Port() != "" {
+		parsedPort, err := strconv.ParseUint(parsedURL.Port(), 10, 16)
+		if err != nil {
+			return errDownloadURLNotAllowed
+		}
+
+		port = uint16(parsedPort)
+	}
+
+	if !e.isPortAllowed(port) {
+		return errDownloadURLNotAllowed
+	}
+
+	if e.isHostAllowed(host) {
+		return nil
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && !e.isAddrAllowed(addr) {
+		return errDownloadURLNotAllowed
+	}
+
+	return nil
+}
+
+// control runs right before connecting to an already resolved address, so the address checked is the one that is
+// connected to, even if the host name is rebound to another address between lookups.
+func (e egressPolicy) control(_ string, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errDownloadAddressBlocked
+	}
+
+	if !e.isPortAllowed(addrPort.Port()) || !e.isAddrAllowed(addrPort.Addr()) {
+		return errDownloadAddressBlocked
+	}
+
+	return nil
+}
+
+// newEgressHTTPClient returns the http client that download tasks are executed with. Every connection it makes,
+// including the ones made to follow redirects, goes through the egress policy.
+func newEgressHTTPClient(policy *egressPolicy) *http.Client {
+	restrictedDialer := &net.Dialer{
+		Timeout:   egressDialTimeout,
+		KeepAlive: egressDialKeepAlive,
+		Control:   policy.control,
+	}
+	trustedDialer := &net.Dialer{
+		Timeout:   egressDialTimeout,
+		KeepAlive: egressDialKeepAlive,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would connect on our behalf, out of reach of the dialer's checks.
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network string, address string) (net.Conn, error) {
+		if host, _, err := net.SplitHostPort(address); err == nil && policy.isHostAllowed(host) {
+			return trustedDialer.DialContext(ctx, network, address)
+		}
+
+		return restrictedDialer.DialContext(ctx, network, address)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(request *http.Request, via []*http.Request) error {
+			if len(via) > policy.maxRedirects {
+				return errTooManyDownloadRedirects
+			}
+
+			return policy.checkURL(request.URL.String())
+		},
+	}
+}
+
+// withRedirectCheck returns a copy of client that also runs checkURL on the target of every redirect.
+func withRedirectCheck(client *http.Client, checkURL func(ctx context.Context, rawURL string) error) *http.Client {
+	checkRedirect := client.CheckRedirect
+	clientWithRedirectCheck := *client
+	clientWithRedirectCheck.CheckRedirect = func(request *http.Request, via []*http.Request) error {
+		if checkRedirect != nil {
+			if err := checkRedirect(request, via); err != nil {
+				return err
+			}
+		}
+
+		return checkURL(request.Context(), request.URL.String())
+	}
+
+	return &clientWithRedirectCheck
+}
+
+// newEgressDownloadError turns a request blocked by the egress policy or by a url policy into a download error that
+// is not retried.
+func newEgressDownloadError(err error) (DownloadError, bool) {
+	for _, egressErr := range []error{
+		errDownloadURLNotAllowed,
+		errDownloadAddressBlocked,
+		errTooManyDownloadRedirects,
+		errURLDeniedByPolicy,
+	} {
+		if errors.Is(err, egressErr) {
+			return DownloadError{Err: egressErr}, true
+		}
+	}
+
+	return DownloadError{}, false
+}
@@ -0,0 +1,129 @@
+package logic
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"goload/internal/configs"
+)
+
+func newTestEgressPolicy(t *testing.T, egressConfig configs.Egress) *egressPolicy {
+	t.Helper()
+
+	policy, err := newEgressPolicy(egressConfig)
+	if err != nil {
+		t.Fatalf("failed to create egress policy: %v", err)
+	}
+
+	return policy
+}
+
+func TestEgressPolicyIsAddrAllowed(t *testing.T) {
+	policy := newTestEgressPolicy(t, configs.Egress{
+		AllowedSchemes: []string{"http", "https"},
+		BlockedCIDRs:   []string{"203.0.114.0/24", "2001:4860::/32"},
+		AllowedCIDRs:   []string{"10.1.0.0/16"},
+	})
+
+	testCases := []struct {
+		name     string
+		addr     string
+		expected bool
+	}{
+		{name: "public ipv4", addr: "93.184.216.34", expected: true},
+		{name: "public ipv6", addr: "2606:4700::1111", expected: true},
+		{name: "unspecified ipv4", addr: "0.0.0.0", expected: false},
+		{name: "this network", addr: "0.1.2.3", expected: false},
+		{name: "unspecified ipv6", addr: "::", expected: false},
+		{name: "loopback ipv4", addr: "127.0.0.1", expected: false},
+		{name: "loopback ipv6", addr: "::1", expected: false},
+		{name: "private ipv4", addr: "192.168.1.1", expected: false},
+		{name: "unique local ipv6", addr: "fd00::1", expected: false},
+		{name: "link local ipv6", addr: "fe80::1", expected: false},
+		{name: "metadata endpoint", addr: "169.254.169.254", expected: false},
+		{name: "ipv4-mapped metadata endpoint", addr: "::ffff:169.254.169.254", expected: false},
+		{name: "ipv4-mapped loopback", addr: "::ffff:127.0.0.1", expected: false},
+		{name: "ipv4-mapped unspecified", addr: "::ffff:0.0.0.0", expected: false},
+		{name: "ipv4-mapped public", addr: "::ffff:93.184.216.34", expected: true},
+		{name: "nat64 metadata endpoint", addr: "64:ff9b::a9fe:a9fe", expected: false},
+		{name: "6to4 loopback", addr: "2002:7f00:1::", expected: false},
+		{name: "configured blocked ipv4", addr: "203.0.114.7", expected: false},
+		{name: "configured blocked ipv6", addr: "2001:4860:4860::8888", expected: false},
+		{name: "configured allowed private", addr: "10.1.2.3", expected: true},
+		{name: "ipv4-mapped configured allowed private", addr: "::ffff:10.1.2.3", expected: true},
+		{name: "private outside configured allowed", addr: "10.2.0.1", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if allowed := policy.isAddrAllowed(netip.MustParseAddr(testCase.addr)); allowed != testCase.expected {
+				t.Fatalf("%s allowed: %t, expected %t", testCase.addr, allowed, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestEgressPolicyCheckURL(t *testing.T) {
+	policy := newTestEgressPolicy(t, configs.Egress{
+		AllowedSchemes: []string{"http", "https"},
+		BlockedPorts:   []uint16{22, 8443},
+		AllowedHosts:   []string{"Internal.Example.com."},
+	})
+
+	testCases := []struct {
+		name        string
+		rawURL      string
+		expectedErr error
+	}{
+		{name: "public host name", rawURL: "https://example.com/file"},
+		{name: "public address", rawURL: "http://93.184.216.34/file"},
+		{name: "scheme not allowed", rawURL: "ftp://example.com/file", expectedErr: errDownloadURLNotAllowed},
+		{name: "missing host", rawURL: "http:///file", expectedErr: errDownloadURLNotAllowed},
+		{name: "blocked port", rawURL: "http://example.com:22/file", expectedErr: errDownloadURLNotAllowed},
+		{name: "blocked port of allowed host", rawURL: "https://internal.example.com:8443/", expectedErr: errDownloadURLNotAllowed},
+		{name: "invalid port", rawURL: "http://example.com:70000/file", expectedErr: errDownloadURLNotAllowed},
+		{name: "metadata endpoint", rawURL: "http://169.254.169.254/latest/meta-data/", expectedErr: errDownloadURLNotAllowed},
+		{name: "ipv4-mapped metadata endpoint", rawURL: "http://[::ffff:169.254.169.254]/", expectedErr: errDownloadURLNotAllowed},
+		{name: "hex ipv4-mapped metadata endpoint", rawURL: "http://[::ffff:a9fe:a9fe]/", expectedErr: errDownloadURLNotAllowed},
+		{name: "unspecified address", rawURL: "http://0.0.0.0:8080/", expectedErr: errDownloadURLNotAllowed},
+		{name: "loopback ipv6", rawURL: "http://[::1]/", expectedErr: errDownloadURLNotAllowed},
+		{name: "allowed host", rawURL: "https://internal.example.com/file"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if err := policy.checkURL(testCase.rawURL); !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("checking %s returned %v, expected %v", testCase.rawURL, err, testCase.expectedErr)
+			}
+		})
+	}
+}
+
+func TestEgressPolicyControl(t *testing.T) {
+	policy := newTestEgressPolicy(t, configs.Egress{
+		AllowedSchemes: []string{"http", "https"},
+		BlockedPorts:   []uint16{25},
+	})
+
+	testCases := []struct {
+		name        string
+		address     string
+		expectedErr error
+	}{
+		{name: "public address", address: "93.184.216.34:443"},
+		{name: "blocked port", address: "93.184.216.34:25", expectedErr: errDownloadAddressBlocked},
+		{name: "metadata endpoint", address: "169.254.169.254:80", expectedErr: errDownloadAddressBlocked},
+		{name: "ipv4-mapped metadata endpoint", address: "[::ffff:169.254.169.254]:80", expectedErr: errDownloadAddressBlocked},
+		{name: "unspecified address", address: "0.0.0.0:80", expectedErr: errDownloadAddressBlocked},
+		{name: "malformed address", address: "example.com:80", expectedErr: errDownloadAddressBlocked},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if err := policy.control("tcp", testCase.address, nil); !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("connecting to %s returned %v, expected %v", testCase.address, err, testCase.expectedErr)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ package logic
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strings"
 
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -14,42 +14,95 @@ import (
 var (
 	ErrHashHashDataFailed      = status.Error(codes.Internal, "failed to hash data")
 	ErrHashCompareHashedFailed = status.Error(codes.Internal, "failed to compare hashed")
+
+	errHashAlgorithmUnknown = status.Error(codes.Internal, "hash is made by an unknown algorithm")
 )
 
 type HashService interface {
 	Hash(ctx context.Context, data string) (string, error)
 	IsHashEqual(ctx context.Context, data string, hashed string) (bool, error)
+	// NeedsRehash tells if hashed was made by a different algorithm or with different parameters than the ones
+	// that are configured now, meaning the data should be hashed again once it is known.
+	NeedsRehash(ctx context.Context, hashed string) bool
+}
+
+// hasher is a single hash algorithm. Its hashes start with a prefix identifying the algorithm, followed by the
+// parameters they were made with.
+type hasher interface {
+	hash(data string) (string, error)
+	isHashEqual(data string, hashed string) (bool, error)
+	isHashMadeBy(hashed string) bool
+	needsRehash(hashed string) bool
 }
 
 type hashService struct {
-	authConfig configs.Auth
+	currentHasher hasher
+	hasherList    []hasher
 }
 
-func NewHashService(authConfig configs.Auth) HashService {
-	return &hashService{
-		authConfig: authConfig,
+func NewHashService(authConfig configs.Auth) (HashService, error) {
+	bcryptHasher := newBcryptHasher(authConfig.Hash.Cost)
+	argon2idHasher, err := newArgon2idHasher(authConfig.Hash.Argon2id)
+	if err != nil {
+		return nil, err
 	}
+
+	hashService := &hashService{
+		hasherList: []hasher{bcryptHasher, argon2idHasher},
+	}
+
+	switch authConfig.Hash.Algorithm {
+	case "", configs.HashAlgorithmBcrypt:
+		hashService.currentHasher = bcryptHasher
+	case configs.HashAlgorithmArgon2id:
+		hashService.currentHasher = argon2idHasher
+	default:
+		return nil, fmt.Errorf("hash algorithm is unsupported: %s", authConfig.Hash.Algorithm)
+	}
+
+	return hashService, nil
 }
 
-// Hash implements HashService.
-func (h *hashService) Hash(ctx context.Context, data string) (string, error) {
-	hashed, err := bcrypt.GenerateFromPassword([]byte(data), h.authConfig.Hash.Cost)
-	if err != nil {
-		return "", ErrHashHashDataFailed
+func (h hashService) getHasher(hashed string) (hasher, error) {
+	for _, hasher := range h.hasherList {
+		if hasher.isHashMadeBy(hashed) {
+			return hasher, nil
+		}
 	}
 
-	return string(hashed), nil
+	return nil, errHashAlgorithmUnknown
+}
+
+// Hash implements HashService.
+func (h *hashService) Hash(ctx context.Context, data string) (string, error) {
+	return h.currentHasher.hash(data)
 }
 
 // IsHashEqual implements HashService.
 func (h *hashService) IsHashEqual(ctx context.Context, data string, hashed string) (bool, error) {
-	err := bcrypt.CompareHashAndPassword([]byte(hashed), []byte(data))
+	hasher, err := h.getHasher(hashed)
 	if err != nil {
-		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			return false, nil
+		return false, err
+	}
+
+	return hasher.isHashEqual(data, hashed)
+}
+
+// NeedsRehash implements HashService.
+func (h *hashService) NeedsRehash(ctx context.Context, hashed string) bool {
+	if !h.currentHasher.isHashMadeBy(hashed) {
+		return true
+	}
+
+	return h.currentHasher.needsRehash(hashed)
+}
+
+func hasAnyPrefix(s string, prefixList ...string) bool {
+	for _, prefix := range prefixList {
+		if strings.HasPrefix(s, prefix) {
+			return true
 		}
-		return false, ErrHashCompareHashedFailed
 	}
 
-	return true, nil
+	return false
 }
@@ -0,0 +1,142 @@
+package logic
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+
+	"goload/internal/configs"
+)
+
+const (
+	argon2idHashPrefix = "$argon2id$"
+)
+
+var (
+	errInvalidArgon2idConfig = errors.New("argon2id memory, iterations, parallelism, salt length and key length " +
+		"must all be positive")
+	errInvalidArgon2idHash = errors.New("argon2id hash is malformed")
+)
+
+// argon2idParams are the parameters of an argon2id hash, which is encoded in the PHC string format:
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>.
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+}
+
+type argon2idHasher struct {
+	params argon2idParams
+}
+
+// newArgon2idHasher accepts an empty config, in which case the hasher can only verify existing hashes.
+func newArgon2idHasher(argon2idConfig configs.Argon2id) (hasher, error) {
+	params := argon2idParams{
+		memory:      argon2idConfig.Memory,
+		iterations:  argon2idConfig.Iterations,
+		parallelism: argon2idConfig.Parallelism,
+		saltLength:  argon2idConfig.SaltLength,
+		keyLength:   argon2idConfig.KeyLength,
+	}
+	if params != (argon2idParams{}) &&
+		(params.memory == 0 || params.iterations == 0 || params.parallelism == 0 ||
+			params.saltLength == 0 || params.keyLength == 0) {
+		return nil, errInvalidArgon2idConfig
+	}
+
+	return &argon2idHasher{
+		params: params,
+	}, nil
+}
+
+func (a argon2idHasher) hash(data string) (string, error) {
+	if a.params == (argon2idParams{}) {
+		return "", ErrHashHashDataFailed
+	}
+
+	salt := make([]byte, a.params.saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", ErrHashHashDataFailed
+	}
+
+	key := argon2.IDKey([]byte(data), salt, a.params.iterations, a.params.memory, a.params.parallelism, a.params.keyLength)
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idHashPrefix,
+		argon2.Version,
+		a.params.memory,
+		a.params.iterations,
+		a.params.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a argon2idHasher) isHashEqual(data string, hashed string) (bool, error) {
+	params, salt, key, err := a.decodeHash(hashed)
+	if err != nil {
+		return false, ErrHashCompareHashedFailed
+	}
+
+	dataKey := argon2.IDKey([]byte(data), salt, params.iterations, params.memory, params.parallelism, params.keyLength)
+	return subtle.ConstantTimeCompare(key, dataKey) == 1, nil
+}
+
+func (a argon2idHasher) isHashMadeBy(hashed string) bool {
+	return strings.HasPrefix(hashed, argon2idHashPrefix)
+}
+
+func (a argon2idHasher) needsRehash(hashed string) bool {
+	params, _, _, err := a.decodeHash(hashed)
+	if err != nil {
+		return true
+	}
+
+	return params != a.params
+}
+
+func (a argon2idHasher) decodeHash(hashed string) (argon2idParams, []byte, []byte, error) {
+	// The hash splits into "", "argon2id", the version, the parameters, the salt and the key.
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 6 {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	params := argon2idParams{}
+	if _, err := fmt.Sscanf(
+		parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism,
+	); err != nil {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+	// argon2 panics on zero iterations or parallelism.
+	if params.memory == 0 || params.iterations == 0 || params.parallelism == 0 {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return argon2idParams{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	params.saltLength = uint32(len(salt))
+	params.keyLength = uint32(len(key))
+	return params, salt, key, nil
+}
@@ -0,0 +1,181 @@
+package logic
+
+import (
+	"errors"
+	"testing"
+
+	"goload/internal/configs"
+)
+
+// testArgon2idConfig keeps the hashes cheap to compute.
+var testArgon2idConfig = configs.Argon2id{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func newTestArgon2idHasher(t *testing.T, argon2idConfig configs.Argon2id) *argon2idHasher {
+	t.Helper()
+
+	h, err := newArgon2idHasher(argon2idConfig)
+	if err != nil {
+		t.Fatalf("failed to create argon2id hasher: %v", err)
+	}
+
+	return h.(*argon2idHasher)
+}
+
+func TestArgon2idHasherDecodeHash(t *testing.T) {
+	hasher := newTestArgon2idHasher(t, testArgon2idConfig)
+
+	hashed, err := hasher.hash("password")
+	if err != nil {
+		t.Fatalf("failed to hash: %v", err)
+	}
+
+	params, salt, key, err := hasher.decodeHash(hashed)
+	if err != nil {
+		t.Fatalf("failed to decode %s: %v", hashed, err)
+	}
+	if params != hasher.params || len(salt) != int(testArgon2idConfig.SaltLength) ||
+		len(key) != int(testArgon2idConfig.KeyLength) {
+		t.Fatalf("decoded %+v with %d bytes of salt and %d bytes of key, expected %+v", params, len(salt), len(key), hasher.params)
+	}
+
+	testCases := []struct {
+		name   string
+		hashed string
+	}{
+		{name: "empty", hashed: ""},
+		{name: "bcrypt hash", hashed: "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy"},
+		{name: "missing key", hashed: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA"},
+		{name: "extra part", hashed: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5$a2V5"},
+		{name: "missing version", hashed: "$argon2id$$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "unsupported version", hashed: "$argon2id$v=16$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "non numeric version", hashed: "$argon2id$v=x$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "missing parameters", hashed: "$argon2id$v=19$m=64$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "non numeric memory", hashed: "$argon2id$v=19$m=x,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "negative iterations", hashed: "$argon2id$v=19$m=64,t=-1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "parallelism overflow", hashed: "$argon2id$v=19$m=64,t=1,p=256$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "zero memory", hashed: "$argon2id$v=19$m=0,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "zero iterations", hashed: "$argon2id$v=19$m=64,t=0,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "zero parallelism", hashed: "$argon2id$v=19$m=64,t=1,p=0$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5"},
+		{name: "invalid salt", hashed: "$argon2id$v=19$m=64,t=1,p=1$!!!$a2V5a2V5a2V5"},
+		{name: "padded salt", hashed: "$argon2id$v=19$m=64,t=1,p=1$c2FsdA==$a2V5a2V5a2V5"},
+		{name: "invalid key", hashed: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$!!!"},
+		{name: "empty key", hashed: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if _, _, _, err := hasher.decodeHash(testCase.hashed); !errors.Is(err, errInvalidArgon2idHash) {
+				t.Fatalf("decoding %q returned %v, expected %v", testCase.hashed, err, errInvalidArgon2idHash)
+			}
+
+			// A malformed hash never matches, and must not reach argon2 with parameters it panics on.
+			if equal, err := hasher.isHashEqual("password", testCase.hashed); equal || !errors.Is(err, ErrHashCompareHashedFailed) {
+				t.Fatalf("comparing with %q returned %t, %v, expected %v", testCase.hashed, equal, err, ErrHashCompareHashedFailed)
+			}
+		})
+	}
+}
+
+func TestArgon2idHasherNeedsRehash(t *testing.T) {
+	hasher := newTestArgon2idHasher(t, testArgon2idConfig)
+
+	hashed, err := hasher.hash("password")
+	if err != nil {
+		t.Fatalf("failed to hash: %v", err)
+	}
+
+	if equal, err := hasher.isHashEqual("password", hashed); err != nil || !equal {
+		t.Fatalf("comparing with its own hash returned %t, %v", equal, err)
+	}
+	if equal, err := hasher.isHashEqual("other password", hashed); err != nil || equal {
+		t.Fatalf("comparing another password returned %t, %v", equal, err)
+	}
+
+	withConfig := func(update func(argon2idConfig *configs.Argon2id)) configs.Argon2id {
+		argon2idConfig := testArgon2idConfig
+		update(&argon2idConfig)
+		return argon2idConfig
+	}
+
+	testCases := []struct {
+		name           string
+		argon2idConfig configs.Argon2id
+		hashed         string
+		expected       bool
+	}{
+		{
+			name:           "same parameters",
+			argon2idConfig: testArgon2idConfig,
+			hashed:         hashed,
+			expected:       false,
+		},
+		{
+			name:           "more memory",
+			argon2idConfig: withConfig(func(c *configs.Argon2id) { c.Memory *= 2 }),
+			hashed:         hashed,
+			expected:       true,
+		},
+		{
+			name:           "more iterations",
+			argon2idConfig: withConfig(func(c *configs.Argon2id) { c.Iterations++ }),
+			hashed:         hashed,
+			expected:       true,
+		},
+		{
+			name:           "more parallelism",
+			argon2idConfig: withConfig(func(c *configs.Argon2id) { c.Parallelism++ }),
+			hashed:         hashed,
+			expected:       true,
+		},
+		{
+			name:           "longer salt",
+			argon2idConfig: withConfig(func(c *configs.Argon2id) { c.SaltLength *= 2 }),
+			hashed:         hashed,
+			expected:       true,
+		},
+		{
+			name:           "longer key",
+			argon2idConfig: withConfig(func(c *configs.Argon2id) { c.KeyLength *= 2 }),
+			hashed:         hashed,
+			expected:       true,
+		},
+		{
+			name:           "verify only hasher",
+			argon2idConfig: configs.Argon2id{},
+			hashed:         hashed,
+			expected:       true,
+		},
+		{
+			name:           "malformed hash",
+			argon2idConfig: testArgon2idConfig,
+			hashed:         "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$",
+			expected:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rehasher := newTestArgon2idHasher(t, testCase.argon2idConfig)
+			if needsRehash := rehasher.needsRehash(testCase.hashed); needsRehash != testCase.expected {
+				t.Fatalf("needs rehash: %t, expected %t", needsRehash, testCase.expected)
+			}
+
+			// The hash that replaces it is up to date.
+			if testCase.expected && testCase.argon2idConfig != (configs.Argon2id{}) {
+				rehashed, err := rehasher.hash("password")
+				if err != nil {
+					t.Fatalf("failed to rehash: %v", err)
+				}
+				if rehasher.needsRehash(rehashed) {
+					t.Fatalf("%s needs rehash right after hashing", rehashed)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errHashDataTooLong = status.Error(codes.InvalidArgument, "data is too long to be hashed by bcrypt")
+)
+
+type bcryptHasher struct {
+	cost int
+}
+
+func newBcryptHasher(cost int) hasher {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+
+	return &bcryptHasher{
+		cost: cost,
+	}
+}
+
+// hash refuses data longer than 72 bytes instead of letting bcrypt ignore everything past them.
+func (b bcryptHasher) hash(data string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(data), b.cost)
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return "", errHashDataTooLong
+		}
+		return "", ErrHashHashDataFailed
+	}
+
+	return string(hashed), nil
+}
+
+func (b bcryptHasher) isHashEqual(data string, hashed string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hashed), []byte(data))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) || errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return false, nil
+		}
+		return false, ErrHashCompareHashedFailed
+	}
+
+	return true, nil
+}
+
+func (b bcryptHasher) isHashMadeBy(hashed string) bool {
+	return hasAnyPrefix(hashed, "$2a$", "$2b$", "$2y$")
+}
+
+func (b bcryptHasher) needsRehash(hashed string) bool {
+	cost, err := bcrypt.Cost([]byte(hashed))
+	if err != nil {
+		return true
+	}
+
+	return cost != b.cost
+}
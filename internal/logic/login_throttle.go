@@ -0,0 +1,205 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
+	"goload/internal/utils"
+)
+
+const (
+	// Past this many doublings the lockout is well above any sensible max lockout anyway.
+	loginLockoutMaxDoublingCount = 30
+)
+
+var (
+	errInvalidLoginThrottleConfig = errors.New("login throttle initial lockout must be positive and no longer than " +
+		"the max lockout, which must be no longer than the failure window")
+)
+
+// loginThrottle slows down password guessing by locking an account, and separately the client IP the guesses come
+// from, out of logging in for a while once too many logins have failed.
+type loginThrottle struct {
+	loginAttempt         cache.LoginAttempt
+	failureWindow        time.Duration
+	accountFreeAttempts  int64
+	clientIPFreeAttempts int64
+	initialLockout       time.Duration
+	maxLockout           time.Duration
+	logger               *zap.Logger
+}
+
+func newLoginThrottle(
+	loginAttempt cache.LoginAttempt,
+	loginThrottleConfig configs.LoginThrottle,
+	logger *zap.Logger,
+) (*loginThrottle, error) {
+	failureWindow, err := loginThrottleConfig.GetFailureWindowDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	initialLockout, err := loginThrottleConfig.GetInitialLockoutDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	maxLockout, err := loginThrottleConfig.GetMaxLockoutDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	// The failure count has to outlive the lockout, otherwise it would start over instead of growing the lockout.
+	if initialLockout <= 0 || initialLockout > maxLockout || maxLockout > failureWindow {
+		return nil, errInvalidLoginThrottleConfig
+	}
+
+	return &loginThrottle{
+		loginAttempt:         loginAttempt,
+		failureWindow:        failureWindow,
+		accountFreeAttempts:  loginThrottleConfig.AccountFreeAttempts,
+		clientIPFreeAttempts: loginThrottleConfig.ClientIPFreeAttempts,
+		initialLockout:       initialLockout,
+		maxLockout:           maxLockout,
+		logger:               logger,
+	}, nil
+}
+
+func (l loginThrottle) getAccountKey(accountName string) string {
+	return fmt.Sprintf("account:%s", accountName)
+}
+
+func (l loginThrottle) getClientIPKey(clientIP string) string {
+	return fmt.Sprintf("client_ip:%s", clientIP)
+}
+
+// getKeys returns the keys a login is throttled by, along with how many failures each of them is allowed before
+// being locked out. The client IP is left out when it is not known.
+func (l loginThrottle) getKeys(accountName string, clientIP string) map[string]int64 {
+	keys := map[string]int64{l.getAccountKey(accountName): l.accountFreeAttempts}
+	if clientIP != "" {
+		keys[l.getClientIPKey(clientIP)] = l.clientIPFreeAttempts
+	}
+
+	return keys
+}
+
+// CheckLockout returns a ResourceExhausted error telling when to retry if the login is locked out. Throttling fails
+// open, a cache outage is logged but does not stop users from logging in.
+func (l loginThrottle) CheckLockout(ctx context.Context, accountName string, clientIP string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).
+		With(zap.String("account_name", accountName)).
+		With(zap.String("client_ip", clientIP))
+
+	var lockedUntil time.Time
+	for key := range l.getKeys(accountName, clientIP) {
+		keyLockedUntil, locked, err := l.loginAttempt.GetLockedUntil(ctx, key)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to check login lockout, allowing login")
+			continue
+		}
+
+		if locked && keyLockedUntil.After(lockedUntil) {
+			lockedUntil = keyLockedUntil
+		}
+	}
+
+	if lockedUntil.IsZero() {
+		return nil
+	}
+
+	logger.With(zap.Time("locked_until", lockedUntil)).Info("rejected login that is locked out")
+	return l.withRetryInfo(
+		status.New(codes.ResourceExhausted, "too many failed login attempts, try again later"),
+		time.Until(lockedUntil))
+}
+
+// RecordFailure counts a failed login and locks it out if it ran out of free attempts. The returned error is
+// wrongPasswordErr, with a retry hint attached if a lockout was placed.
+func (l loginThrottle) RecordFailure(
+	ctx context.Context,
+	accountName string,
+	clientIP string,
+	wrongPasswordErr error,
+) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).
+		With(zap.String("account_name", accountName)).
+		With(zap.String("client_ip", clientIP))
+
+	var lockout time.Duration
+	for key, freeAttempts := range l.getKeys(accountName, clientIP) {
+		failureCount, err := l.loginAttempt.AddFailure(ctx, key, l.failureWindow)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to record login failure")
+			continue
+		}
+
+		if failureCount <= freeAttempts {
+			continue
+		}
+
+		keyLockout := l.getLockout(failureCount - freeAttempts)
+		lockedUntil := time.Now().Add(keyLockout)
+		if err = l.loginAttempt.Lock(ctx, key, lockedUntil); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to lock out login")
+			continue
+		}
+
+		logger.
+			With(zap.String("key", key)).
+			With(zap.Int64("failure_count", failureCount)).
+			With(zap.Time("locked_until", lockedUntil)).
+			Warn("locked out login after too many failed attempts")
+
+		if keyLockout > lockout {
+			lockout = keyLockout
+		}
+	}
+
+	if lockout == 0 {
+		return wrongPasswordErr
+	}
+
+	return l.withRetryInfo(status.Convert(wrongPasswordErr), lockout)
+}
+
+// RecordSuccess forgets the failed logins of the account. Failures of the client IP are kept, otherwise logging
+// into an account of their own would let a client keep guessing the passwords of others.
+func (l loginThrottle) RecordSuccess(ctx context.Context, accountName string) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("account_name", accountName))
+
+	if err := l.loginAttempt.ResetFailures(ctx, l.getAccountKey(accountName)); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to reset login failures")
+	}
+}
+
+// getLockout doubles the lockout with every failure past the free attempts.
+func (l loginThrottle) getLockout(lockoutCount int64) time.Duration {
+	lockout := l.initialLockout
+	for i := int64(1); i < lockoutCount && i < loginLockoutMaxDoublingCount && lockout < l.maxLockout; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, l.maxLockout)
+}
+
+func (l loginThrottle) withRetryInfo(grpcStatus *status.Status, retryDelay time.Duration) error {
+	statusWithDetails, err := grpcStatus.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay.Round(time.Second)),
+	})
+	if err != nil {
+		return grpcStatus.Err()
+	}
+
+	return statusWithDetails.Err()
+}
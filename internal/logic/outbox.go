@@ -0,0 +1,117 @@
+package logic
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/mq/producer"
+	"goload/internal/utils"
+)
+
+const (
+	outboxRelayBatchSize = 100
+	// The relay runs every few seconds, the sent messages do not need to be deleted that often.
+	outboxSentMessageDeleteInterval = time.Minute
+)
+
+type OutboxService interface {
+	// RelayOutboxMessages publishes the outbox messages that have not been sent yet and marks them as sent.
+	RelayOutboxMessages(ctx context.Context) error
+	// DeleteExpiredOutboxMessages deletes the messages that were sent longer than the retention period ago. It does
+	// nothing if it already ran in the last outboxSentMessageDeleteInterval.
+	DeleteExpiredOutboxMessages(ctx context.Context) error
+}
+
+type outboxService struct {
+	database                *goqu.Database
+	outboxMessageRepository database.OutboxMessageRepository
+	mqClient                producer.Client
+	retention               time.Duration
+	lastDeletedAt           time.Time
+	deleteMutex             *sync.Mutex
+	logger                  *zap.Logger
+}
+
+func NewOutboxService(
+	database *goqu.Database,
+	outboxMessageRepository database.OutboxMessageRepository,
+	mqClient producer.Client,
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (OutboxService, error) {
+	retention, err := mqConfig.GetOutboxRetentionDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	return &outboxService{
+		database:                database,
+		outboxMessageRepository: outboxMessageRepository,
+		mqClient:                mqClient,
+		retention:               retention,
+		deleteMutex:             new(sync.Mutex),
+		logger:                  logger,
+	}, nil
+}
+
+// RelayOutboxMessages implements OutboxService.
+func (o *outboxService) RelayOutboxMessages(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	var produceErr error
+	txnErr := o.database.WithTx(func(td *goqu.TxDatabase) error {
+		outboxMessageList, err := o.outboxMessageRepository.
+			WithDatabase(td).
+			GetUnsentOutboxMessageListWithXLock(ctx, outboxRelayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, outboxMessage := range outboxMessageList {
+			// Stop at the first failure but still commit the messages sent so far, so they are not sent twice.
+			if produceErr = o.mqClient.Produce(ctx, outboxMessage.Topic, outboxMessage.Payload); produceErr != nil {
+				logger.With(zap.Uint64("id", outboxMessage.ID)).With(zap.Error(produceErr)).Error("failed to relay outbox message")
+				return nil
+			}
+
+			if err = o.outboxMessageRepository.WithDatabase(td).MarkOutboxMessageSent(ctx, outboxMessage.ID, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if txnErr != nil {
+		return txnErr
+	}
+
+	return produceErr
+}
+
+// DeleteExpiredOutboxMessages implements OutboxService.
+func (o *outboxService) DeleteExpiredOutboxMessages(ctx context.Context) error {
+	o.deleteMutex.Lock()
+	defer o.deleteMutex.Unlock()
+
+	if time.Since(o.lastDeletedAt) < outboxSentMessageDeleteInterval {
+		return nil
+	}
+
+	deletedCount, err := o.outboxMessageRepository.DeleteOutboxMessageListSentBefore(ctx, time.Now().Add(-o.retention))
+	if err != nil {
+		return err
+	}
+
+	o.lastDeletedAt = time.Now()
+	if deletedCount > 0 {
+		utils.LoggerWithContext(ctx, o.logger).With(zap.Int64("count", deletedCount)).Info("deleted sent outbox messages")
+	}
+
+	return nil
+}
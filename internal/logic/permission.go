@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"goload/internal/dataaccess/database"
+	"goload/internal/generated/grpc/goload"
+)
+
+// Permission is something an account may do on top of managing what it owns, which every account can.
+type Permission uint8
+
+const (
+	// PermissionManageAnyDownloadTask lets an account see and manage the download tasks of every account.
+	PermissionManageAnyDownloadTask Permission = iota + 1
+	// PermissionManageAccounts lets an account disable accounts and change their roles.
+	PermissionManageAccounts
+	// PermissionManageURLPolicies lets an account restrict the urls that accounts may download from.
+	PermissionManageURLPolicies
+)
+
+var (
+	errPermissionDenied = status.Error(codes.PermissionDenied, "account does not have the permission to do this")
+)
+
+var rolePermissionList = map[goload.Role][]Permission{
+	goload.Role_Operator: {PermissionManageAnyDownloadTask},
+	goload.Role_Admin:    {PermissionManageAnyDownloadTask, PermissionManageAccounts, PermissionManageURLPolicies},
+}
+
+// HasPermission tells if accounts with role have permission. Accounts made before roles were introduced have an
+// undefined role, which has no permissions just like the user role.
+func HasPermission(role goload.Role, permission Permission) bool {
+	for _, rolePermission := range rolePermissionList[role] {
+		if rolePermission == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkPermission(account database.Account, permission Permission) error {
+	if !HasPermission(account.Role, permission) {
+		return errPermissionDenied
+	}
+
+	return nil
+}
+
+// canManageDownloadTask tells if account owns downloadTask, or may manage the download tasks of every account.
+func canManageDownloadTask(account database.Account, downloadTask database.DownloadTask) bool {
+	return account.ID == downloadTask.OfAccountID || HasPermission(account.Role, PermissionManageAnyDownloadTask)
+}
@@ -2,24 +2,39 @@ package logic
 
 import (
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
-	"database/sql"
+	"encoding/base64"
 	"encoding/pem"
 	"errors"
+	"math/big"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
 	"goload/internal/dataaccess/database"
+	"goload/internal/generated/grpc/goload"
+	"goload/internal/utils"
 )
 
 const (
 	rs512Bits = 2048
+
+	jwkKeyTypeRSA      = "RSA"
+	jwkUseSignature    = "sig"
+	jwkAlgorithmRS512  = "RS512"
+	masterKeySizeBytes = 32
 )
 
 var (
@@ -29,22 +44,69 @@ var (
 	errGetTokensSubClaimFailed = status.Error(codes.Unauthenticated, "failed to get token's sub claim")
 	errGetTokensExpClaimFailed = status.Error(codes.Unauthenticated, "failed to get token's exp claim")
 	errTokenPublicKeyNotFound  = status.Error(codes.Unauthenticated, "token public key not found")
+	errTokenPublicKeyExpired   = status.Error(codes.Unauthenticated, "token public key expired")
 	errTokenInvalidToken       = status.Error(codes.Unauthenticated, "invalid token")
+	errTokenRevoked            = status.Error(codes.Unauthenticated, "token is revoked")
+	errCheckTokenRevokedFailed = status.Error(codes.Unavailable, "failed to check if token is revoked")
+	errRevokeSessionFailed     = status.Error(codes.Internal, "failed to revoke session")
 	errTokenSignTokenFailed    = status.Error(codes.Internal, "failed to sign token")
+	errGetSigningKeyFailed     = status.Error(codes.Internal, "failed to get token signing key")
+	errGetPublicKeyListFailed  = status.Error(codes.Internal, "failed to get token public keys")
+	errInvalidMasterKey        = errors.New("master key must be a base64 encoded 32 bytes key")
+	errDecryptPrivateKeyFailed = errors.New("failed to decrypt private key")
+	errDecodePrivateKeyFailed  = errors.New("failed to decode private key")
 )
 
+// JSONWebKey is the public part of a token signing key, in the format of RFC 7517.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// TokenClaims is what an access token says about its bearer.
+type TokenClaims struct {
+	AccountID  uint64
+	Role       goload.Role
+	ExpireTime time.Time
+}
+
 type TokenService interface {
-	GetToken(ctx context.Context, accountID uint64) (string, error)
-	ParseAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error)
+	GetToken(ctx context.Context, accountID uint64, sessionID uint64, role goload.Role) (string, error)
+	// ParseToken rejects tokens whose session has been revoked.
+	ParseToken(ctx context.Context, token string) (TokenClaims, error)
+	// RevokeSession makes the access tokens issued for a session invalid before they expire.
+	RevokeSession(ctx context.Context, sessionID uint64) error
+	// GetJSONWebKeySet returns every public key that tokens can currently be verified with.
+	GetJSONWebKeySet(ctx context.Context) (JSONWebKeySet, error)
+}
+
+type signingKey struct {
+	publicKeyID uint64
+	privateKey  *rsa.PrivateKey
+	createdAt   time.Time
 }
 
 type tokenService struct {
+	database            *goqu.Database
 	accountRepository   database.AccountRepository
 	publicKeyRepository database.PublicKeyRepository
+	revokedSession      cache.RevokedSession
 	authConfig          configs.Auth
-	publicKeyID         uint64
-	privateKey          *rsa.PrivateKey
 	expiresIn           time.Duration
+	rotationInterval    time.Duration
+	gracePeriod         time.Duration
+	masterKey           cipher.AEAD
+	signingKeyMutex     sync.Mutex
+	signingKey          *signingKey
+	logger              *zap.Logger
 }
 
 func generateRSAKeyPair(bits int) (*rsa.PrivateKey, error) {
@@ -70,67 +132,116 @@ func encodePublicKeyToPEM(publicKey *rsa.PublicKey) (string, error) {
 	return string(pubPEM), nil
 }
 
-func (t tokenService) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey, error) {
+func encodePrivateKeyToPEM(privateKey *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+}
+
+func newMasterKey(encodedMasterKey string) (cipher.AEAD, error) {
+	if encodedMasterKey == "" {
+		return nil, nil
+	}
+
+	masterKey, err := base64.StdEncoding.DecodeString(encodedMasterKey)
+	if err != nil || len(masterKey) != masterKeySizeBytes {
+		return nil, errInvalidMasterKey
+	}
+
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (t *tokenService) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey, error) {
 	tokenPublicKey, err := t.publicKeyRepository.GetPublicKeyByID(ctx, id)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, database.ErrPublicKeyNotFound) {
 			return nil, errTokenPublicKeyNotFound
 		}
 
 		return nil, err
 	}
 
+	if time.Now().After(tokenPublicKey.ExpiresAt) {
+		return nil, errTokenPublicKeyExpired
+	}
+
 	return jwt.ParseRSAPublicKeyFromPEM([]byte(tokenPublicKey.PublicKey))
 }
 
 func NewTokenService(
+	database *goqu.Database,
 	accounRepository database.AccountRepository,
 	publicKeyRepository database.PublicKeyRepository,
+	revokedSession cache.RevokedSession,
 	authConfig configs.Auth,
+	logger *zap.Logger,
 ) (TokenService, error) {
 	expiresIn, err := authConfig.Token.GetExpiresInDuration()
 	if err != nil {
 		return nil, err
 	}
 
-	privateKey, err := generateRSAKeyPair(rs512Bits)
+	rotationInterval, err := authConfig.Token.SigningKey.GetRotationIntervalDuration()
 	if err != nil {
 		return nil, err
 	}
 
-	publicKeyPEM, err := encodePublicKeyToPEM(&privateKey.PublicKey)
+	gracePeriod, err := authConfig.Token.SigningKey.GetGracePeriodDuration()
 	if err != nil {
 		return nil, err
 	}
 
-	publicKeyID, err := publicKeyRepository.CreatePublicKey(
-		context.Background(),
-		database.PublicKey{PublicKey: publicKeyPEM},
-	)
+	masterKey, err := newMasterKey(authConfig.Token.SigningKey.MasterKey)
 	if err != nil {
 		return nil, err
 	}
 
-	return &tokenService{
+	service := &tokenService{
+		database:            database,
 		accountRepository:   accounRepository,
 		publicKeyRepository: publicKeyRepository,
+		revokedSession:      revokedSession,
 		authConfig:          authConfig,
-		publicKeyID:         publicKeyID,
-		privateKey:          privateKey,
 		expiresIn:           expiresIn,
-	}, nil
+		rotationInterval:    rotationInterval,
+		gracePeriod:         gracePeriod,
+		masterKey:           masterKey,
+		logger:              logger,
+	}
+
+	// Load the signing key right away, so that a broken key setup stops the server from starting.
+	if _, err = service.getSigningKey(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return service, nil
 }
 
 // GetToken implements TokenService.
-func (t *tokenService) GetToken(ctx context.Context, accountID uint64) (string, error) {
+func (t *tokenService) GetToken(ctx context.Context, accountID uint64, sessionID uint64, role goload.Role) (string, error) {
+	signingKey, err := t.getSigningKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	expireTime := time.Now().Add(t.expiresIn)
 	token := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.MapClaims{
-		"sub": accountID,
-		"exp": expireTime.Unix(),
-		"kid": t.publicKeyID,
+		"sub":  accountID,
+		"exp":  expireTime.Unix(),
+		"kid":  signingKey.publicKeyID,
+		"sid":  sessionID,
+		"role": int32(role),
 	})
+	// Standard JWT libraries look the key up by the kid header, which matches the kid of the JWKS endpoint.
+	token.Header["kid"] = strconv.FormatUint(signingKey.publicKeyID, 10)
 
-	tokenStr, err := token.SignedString(t.privateKey)
+	tokenStr, err := token.SignedString(signingKey.privateKey)
 	if err != nil {
 		return "", errTokenSignTokenFailed
 	}
@@ -138,8 +249,8 @@ func (t *tokenService) GetToken(ctx context.Context, accountID uint64) (string,
 	return tokenStr, nil
 }
 
-// ParseAccountIDAndExpireTime implements TokenService.
-func (t *tokenService) ParseAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error) {
+// ParseToken implements TokenService.
+func (t *tokenService) ParseToken(ctx context.Context, token string) (TokenClaims, error) {
 	parsedToken, err := jwt.Parse(token, func(parsedToken *jwt.Token) (interface{}, error) {
 		if _, ok := parsedToken.Method.(*jwt.SigningMethodRSA); !ok {
 			return nil, errUnexpectedSigningMethod
@@ -159,27 +270,227 @@ func (t *tokenService) ParseAccountIDAndExpireTime(ctx context.Context, token st
 	})
 
 	if err != nil {
-		return 0, time.Time{}, errTokenInvalidToken
+		return TokenClaims{}, errTokenInvalidToken
 	}
 
 	if !parsedToken.Valid {
-		return 0, time.Time{}, errTokenInvalidToken
+		return TokenClaims{}, errTokenInvalidToken
 	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
-		return 0, time.Time{}, errGetTokensClaimsFailed
+		return TokenClaims{}, errGetTokensClaimsFailed
 	}
 
 	accountID, ok := claims["sub"].(float64)
 	if !ok {
-		return 0, time.Time{}, errGetTokensSubClaimFailed
+		return TokenClaims{}, errGetTokensSubClaimFailed
 	}
 
 	expireTimeUnix, ok := claims["exp"].(float64)
 	if !ok {
-		return 0, time.Time{}, errGetTokensExpClaimFailed
+		return TokenClaims{}, errGetTokensExpClaimFailed
+	}
+
+	// Tokens issued before sessions were introduced have no sid claim, and can not be revoked.
+	if sessionID, ok := claims["sid"].(float64); ok {
+		revoked, err := t.revokedSession.Has(ctx, uint64(sessionID))
+		if err != nil {
+			return TokenClaims{}, errCheckTokenRevokedFailed
+		}
+		if revoked {
+			return TokenClaims{}, errTokenRevoked
+		}
+	}
+
+	// Tokens issued before roles were introduced have no role claim, and get the permissions of a user.
+	role, _ := claims["role"].(float64)
+
+	return TokenClaims{
+		AccountID:  uint64(accountID),
+		Role:       goload.Role(role),
+		ExpireTime: time.Unix(int64(expireTimeUnix), 0),
+	}, nil
+}
+
+// RevokeSession implements TokenService.
+func (t *tokenService) RevokeSession(ctx context.Context, sessionID uint64) error {
+	if err := t.revokedSession.Add(ctx, sessionID, t.expiresIn); err != nil {
+		return errRevokeSessionFailed
+	}
+
+	return nil
+}
+
+// GetJSONWebKeySet implements TokenService.
+func (t *tokenService) GetJSONWebKeySet(ctx context.Context) (JSONWebKeySet, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	publicKeyList, err := t.publicKeyRepository.GetUnexpiredPublicKeyList(ctx, time.Now())
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get unexpired public keys")
+		return JSONWebKeySet{}, errGetPublicKeyListFailed
+	}
+
+	keySet := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(publicKeyList))}
+	for _, publicKey := range publicKeyList {
+		rsaPublicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(publicKey.PublicKey))
+		if err != nil {
+			logger.With(zap.Uint64("id", publicKey.ID)).With(zap.Error(err)).Warn("failed to parse public key, skipping it")
+			continue
+		}
+
+		keySet.Keys = append(keySet.Keys, JSONWebKey{
+			KeyType:   jwkKeyTypeRSA,
+			Use:       jwkUseSignature,
+			Algorithm: jwkAlgorithmRS512,
+			KeyID:     strconv.FormatUint(publicKey.ID, 10),
+			N:         base64.RawURLEncoding.EncodeToString(rsaPublicKey.N.Bytes()),
+			E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaPublicKey.E)).Bytes()),
+		})
+	}
+
+	return keySet, nil
+}
+
+// getSigningKey returns the key new tokens are signed with, rotating it once it is older than the rotation
+// interval.
+func (t *tokenService) getSigningKey(ctx context.Context) (*signingKey, error) {
+	t.signingKeyMutex.Lock()
+	defer t.signingKeyMutex.Unlock()
+
+	if t.signingKey != nil && time.Since(t.signingKey.createdAt) < t.rotationInterval {
+		return t.signingKey, nil
+	}
+
+	signingKey, err := t.loadOrCreateSigningKey(ctx)
+	if err != nil {
+		utils.LoggerWithContext(ctx, t.logger).With(zap.Error(err)).Error("failed to get signing key")
+		return nil, errGetSigningKeyFailed
+	}
+
+	t.signingKey = signingKey
+	return signingKey, nil
+}
+
+// loadOrCreateSigningKey returns the latest signing key stored in the database, or creates one if it is missing or
+// due for rotation. The public keys table is locked meanwhile, so replicas that rotate at the same time end up
+// sharing a single new key.
+func (t *tokenService) loadOrCreateSigningKey(ctx context.Context) (*signingKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	var loadedSigningKey *signingKey
+	txnErr := t.database.WithTx(func(td *goqu.TxDatabase) error {
+		publicKeyRepository := t.publicKeyRepository.WithDatabase(td)
+		if err := publicKeyRepository.LockPublicKeys(ctx); err != nil {
+			return err
+		}
+
+		latestPublicKey, err := publicKeyRepository.GetLatestSigningPublicKey(ctx)
+		if err != nil && !errors.Is(err, database.ErrPublicKeyNotFound) {
+			return err
+		}
+
+		if err == nil && time.Since(latestPublicKey.CreatedAt) < t.rotationInterval {
+			privateKey, err := t.decodePrivateKey(latestPublicKey.PrivateKey)
+			if err == nil {
+				loadedSigningKey = &signingKey{
+					publicKeyID: latestPublicKey.ID,
+					privateKey:  privateKey,
+					createdAt:   latestPublicKey.CreatedAt,
+				}
+				return nil
+			}
+
+			logger.With(zap.Uint64("id", latestPublicKey.ID)).With(zap.Error(err)).
+				Warn("failed to decode stored signing key, creating a new one")
+		}
+
+		loadedSigningKey, err = t.createSigningKey(ctx, publicKeyRepository)
+		return err
+	})
+	if txnErr != nil {
+		return nil, txnErr
+	}
+
+	return loadedSigningKey, nil
+}
+
+// createSigningKey stores a new signing key. Its public key stays valid for as long as the tokens signed during
+// the rotation interval live, plus the grace period for replicas that are late to pick up the next key.
+func (t *tokenService) createSigningKey(
+	ctx context.Context,
+	publicKeyRepository database.PublicKeyRepository,
+) (*signingKey, error) {
+	privateKey, err := generateRSAKeyPair(rs512Bits)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyPEM, err := encodePublicKeyToPEM(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedPrivateKey, err := t.encryptPrivateKey(encodePrivateKeyToPEM(privateKey))
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now()
+	publicKeyID, err := publicKeyRepository.CreatePublicKey(ctx, database.PublicKey{
+		PublicKey:  publicKeyPEM,
+		PrivateKey: encryptedPrivateKey,
+		CreatedAt:  createdAt,
+		ExpiresAt:  createdAt.Add(t.rotationInterval + t.expiresIn + t.gracePeriod),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("id", publicKeyID)).Info("token signing key is rotated")
+
+	return &signingKey{
+		publicKeyID: publicKeyID,
+		privateKey:  privateKey,
+		createdAt:   createdAt,
+	}, nil
+}
+
+// encryptPrivateKey seals the private key with the master key, prefixing it with the nonce. Without a master key
+// the private key is stored as is.
+func (t *tokenService) encryptPrivateKey(privateKeyPEM []byte) ([]byte, error) {
+	if t.masterKey == nil {
+		return privateKeyPEM, nil
+	}
+
+	nonce := make([]byte, t.masterKey.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return t.masterKey.Seal(nonce, nonce, privateKeyPEM, nil), nil
+}
+
+func (t *tokenService) decodePrivateKey(storedPrivateKey []byte) (*rsa.PrivateKey, error) {
+	privateKeyPEM := storedPrivateKey
+	if t.masterKey != nil {
+		nonceSize := t.masterKey.NonceSize()
+		if len(storedPrivateKey) < nonceSize {
+			return nil, errDecryptPrivateKeyFailed
+		}
+
+		var err error
+		privateKeyPEM, err = t.masterKey.Open(nil, storedPrivateKey[:nonceSize], storedPrivateKey[nonceSize:], nil)
+		if err != nil {
+			return nil, errDecryptPrivateKeyFailed
+		}
+	}
+
+	block, _ := pem.Decode(privateKeyPEM)
+	if block == nil {
+		return nil, errDecodePrivateKeyFailed
 	}
 
-	return uint64(accountID), time.Unix(int64(expireTimeUnix), 0), nil
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
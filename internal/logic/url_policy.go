@@ -0,0 +1,351 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"goload/internal/dataaccess/database"
+	"goload/internal/generated/grpc/goload"
+	"goload/internal/utils"
+)
+
+var (
+	errURLDeniedByPolicy         = status.Error(codes.PermissionDenied, "download url is denied by policy")
+	errURLPolicyWithoutCondition = status.Error(codes.InvalidArgument, "url policy must have at least one condition")
+	errInvalidURLPolicyAction    = status.Error(codes.InvalidArgument, "url policy action is invalid")
+	errInvalidURLPolicyHostGlob  = status.Error(codes.InvalidArgument, "url policy host glob is invalid")
+	errInvalidURLPolicyURLRegex  = status.Error(codes.InvalidArgument, "url policy url regex is invalid")
+)
+
+type CreateURLPolicyInput struct {
+	AdminAccountID uint64
+	// OfAccountID is the account the policy applies to, every account's when zero.
+	OfAccountID uint64
+	Action      goload.UrlPolicyAction
+	HostGlob    string
+	URLRegex    string
+	Scheme      string
+	Port        uint32
+	Description string
+}
+
+type CreateURLPolicyOutput struct {
+	URLPolicy *goload.UrlPolicy
+}
+
+type GetURLPolicyListInput struct {
+	AdminAccountID uint64
+	// FilterAccountID only lists the policies of this account if it is not zero.
+	FilterAccountID uint64
+	Offset          uint64
+	Limit           uint64
+}
+
+type GetURLPolicyListOutput struct {
+	URLPolicyList       []*goload.UrlPolicy
+	TotalURLPolicyCount uint64
+}
+
+type UpdateURLPolicyInput struct {
+	AdminAccountID uint64
+	URLPolicyID    uint64
+	Action         goload.UrlPolicyAction
+	HostGlob       string
+	URLRegex       string
+	Scheme         string
+	Port           uint32
+	Description    string
+}
+
+type UpdateURLPolicyOutput struct {
+	URLPolicy *goload.UrlPolicy
+}
+
+type DeleteURLPolicyInput struct {
+	AdminAccountID uint64
+	URLPolicyID    uint64
+}
+
+type DeleteURLPolicyOutput struct {
+	Deleted bool
+}
+
+type URLPolicyService interface {
+	CreateURLPolicy(ctx context.Context, input CreateURLPolicyInput) (CreateURLPolicyOutput, error)
+	GetURLPolicyList(ctx context.Context, input GetURLPolicyListInput) (GetURLPolicyListOutput, error)
+	UpdateURLPolicy(ctx context.Context, input UpdateURLPolicyInput) (UpdateURLPolicyOutput, error)
+	DeleteURLPolicy(ctx context.Context, input DeleteURLPolicyInput) (DeleteURLPolicyOutput, error)
+	// CheckURL rejects rawURL if a deny policy that applies to the account matches it, or if the account has allow
+	// policies and none of them matches it.
+	CheckURL(ctx context.Context, accountID uint64, rawURL string) error
+}
+
+type urlPolicyService struct {
+	accountRepository   database.AccountRepository
+	urlPolicyRepository database.URLPolicyRepository
+	logger              *zap.Logger
+}
+
+func NewURLPolicyService(
+	accountRepository database.AccountRepository,
+	urlPolicyRepository database.URLPolicyRepository,
+	logger *zap.Logger,
+) URLPolicyService {
+	return &urlPolicyService{
+		accountRepository:   accountRepository,
+		urlPolicyRepository: urlPolicyRepository,
+		logger:              logger,
+	}
+}
+
+// urlPolicyMatcher is a url policy with its regular expression compiled.
+type urlPolicyMatcher struct {
+	urlPolicy database.URLPolicy
+	urlRegex  *regexp.Regexp
+}
+
+func newURLPolicyMatcher(urlPolicy database.URLPolicy) (urlPolicyMatcher, error) {
+	if urlPolicy.Action != goload.UrlPolicyAction_Allow && urlPolicy.Action != goload.UrlPolicyAction_Deny {
+		return urlPolicyMatcher{}, errInvalidURLPolicyAction
+	}
+
+	if urlPolicy.HostGlob == "" && urlPolicy.URLRegex == "" && urlPolicy.Scheme == "" && urlPolicy.Port == 0 {
+		return urlPolicyMatcher{}, errURLPolicyWithoutCondition
+	}
+
+	if _, err := path.Match(urlPolicy.HostGlob, ""); err != nil {
+		return urlPolicyMatcher{}, errInvalidURLPolicyHostGlob
+	}
+
+	matcher := urlPolicyMatcher{urlPolicy: urlPolicy}
+	if urlPolicy.URLRegex != "" {
+		urlRegex, err := regexp.Compile(urlPolicy.URLRegex)
+		if err != nil {
+			return urlPolicyMatcher{}, errInvalidURLPolicyURLRegex
+		}
+
+		matcher.urlRegex = urlRegex
+	}
+
+	return matcher, nil
+}
+
+func (u urlPolicyMatcher) matches(parsedURL *url.URL) bool {
+	scheme := strings.ToLower(parsedURL.Scheme)
+	if u.urlPolicy.Scheme != "" && u.urlPolicy.Scheme != scheme {
+		return false
+	}
+
+	if u.urlPolicy.HostGlob != "" {
+		if matched, _ := path.Match(normalizeEgressHost(u.urlPolicy.HostGlob), normalizeEgressHost(parsedURL.Hostname())); !matched {
+			return false
+		}
+	}
+
+	if u.urlPolicy.Port != 0 {
+		port := uint64(egressDefaultPorts[scheme])
+		if parsedURL.Port() != "" {
+			port, _ = strconv.ParseUint(parsedURL.Port(), 10, 16)
+		}
+
+		if port != uint64(u.urlPolicy.Port) {
+			return false
+		}
+	}
+
+	return u.urlRegex == nil || u.urlRegex.MatchString(parsedURL.String())
+}
+
+// CreateURLPolicy implements URLPolicyService.
+func (u *urlPolicyService) CreateURLPolicy(ctx context.Context, input CreateURLPolicyInput) (CreateURLPolicyOutput, error) {
+	if err := u.checkManageURLPoliciesPermission(ctx, input.AdminAccountID); err != nil {
+		return CreateURLPolicyOutput{}, err
+	}
+
+	urlPolicy := database.URLPolicy{
+		Action:      input.Action,
+		HostGlob:    input.HostGlob,
+		URLRegex:    input.URLRegex,
+		Scheme:      input.Scheme,
+		Port:        input.Port,
+		Description: input.Description,
+	}
+	if input.OfAccountID != 0 {
+		account, err := u.accountRepository.GetAccountByID(ctx, input.OfAccountID)
+		if err != nil {
+			return CreateURLPolicyOutput{}, err
+		}
+
+		urlPolicy.OfAccountID = sql.NullInt64{Int64: int64(account.ID), Valid: true}
+	}
+
+	if _, err := newURLPolicyMatcher(urlPolicy); err != nil {
+		return CreateURLPolicyOutput{}, err
+	}
+
+	var err error
+	urlPolicy.ID, urlPolicy.CreatedAt, err = u.urlPolicyRepository.CreateURLPolicy(ctx, urlPolicy)
+	if err != nil {
+		return CreateURLPolicyOutput{}, err
+	}
+
+	return CreateURLPolicyOutput{
+		URLPolicy: u.toProtoURLPolicy(urlPolicy),
+	}, nil
+}
+
+// GetURLPolicyList implements URLPolicyService.
+func (u *urlPolicyService) GetURLPolicyList(ctx context.Context, input GetURLPolicyListInput) (GetURLPolicyListOutput, error) {
+	if err := u.checkManageURLPoliciesPermission(ctx, input.AdminAccountID); err != nil {
+		return GetURLPolicyListOutput{}, err
+	}
+
+	totalURLPolicyCount, err := u.urlPolicyRepository.CountURLPolicies(ctx, input.FilterAccountID)
+	if err != nil {
+		return GetURLPolicyListOutput{}, err
+	}
+
+	urlPolicyList, err := u.urlPolicyRepository.GetURLPolicyList(ctx, input.FilterAccountID, input.Offset, input.Limit)
+	if err != nil {
+		return GetURLPolicyListOutput{}, err
+	}
+
+	return GetURLPolicyListOutput{
+		URLPolicyList: lo.Map(urlPolicyList, func(item database.URLPolicy, _ int) *goload.UrlPolicy {
+			return u.toProtoURLPolicy(item)
+		}),
+		TotalURLPolicyCount: totalURLPolicyCount,
+	}, nil
+}
+
+// UpdateURLPolicy implements URLPolicyService.
+func (u *urlPolicyService) UpdateURLPolicy(ctx context.Context, input UpdateURLPolicyInput) (UpdateURLPolicyOutput, error) {
+	if err := u.checkManageURLPoliciesPermission(ctx, input.AdminAccountID); err != nil {
+		return UpdateURLPolicyOutput{}, err
+	}
+
+	urlPolicy, err := u.urlPolicyRepository.GetURLPolicyByID(ctx, input.URLPolicyID)
+	if err != nil {
+		return UpdateURLPolicyOutput{}, err
+	}
+
+	urlPolicy.Action = input.Action
+	urlPolicy.HostGlob = input.HostGlob
+	urlPolicy.URLRegex = input.URLRegex
+	urlPolicy.Scheme = input.Scheme
+	urlPolicy.Port = input.Port
+	urlPolicy.Description = input.Description
+	if _, err = newURLPolicyMatcher(urlPolicy); err != nil {
+		return UpdateURLPolicyOutput{}, err
+	}
+
+	if err = u.urlPolicyRepository.UpdateURLPolicy(ctx, urlPolicy); err != nil {
+		return UpdateURLPolicyOutput{}, err
+	}
+
+	return UpdateURLPolicyOutput{
+		URLPolicy: u.toProtoURLPolicy(urlPolicy),
+	}, nil
+}
+
+// DeleteURLPolicy implements URLPolicyService.
+func (u *urlPolicyService) DeleteURLPolicy(ctx context.Context, input DeleteURLPolicyInput) (DeleteURLPolicyOutput, error) {
+	if err := u.checkManageURLPoliciesPermission(ctx, input.AdminAccountID); err != nil {
+		return DeleteURLPolicyOutput{}, err
+	}
+
+	deleted, err := u.urlPolicyRepository.DeleteURLPolicy(ctx, input.URLPolicyID)
+	if err != nil {
+		return DeleteURLPolicyOutput{}, err
+	}
+
+	if !deleted {
+		return DeleteURLPolicyOutput{}, database.ErrURLPolicyNotFound
+	}
+
+	return DeleteURLPolicyOutput{
+		Deleted: true,
+	}, nil
+}
+
+// CheckURL implements URLPolicyService.
+func (u *urlPolicyService) CheckURL(ctx context.Context, accountID uint64, rawURL string) error {
+	logger := utils.LoggerWithContext(ctx, u.logger).
+		With(zap.Uint64("account_id", accountID)).
+		With(zap.String("url", rawURL))
+
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return errDownloadURLNotAllowed
+	}
+
+	urlPolicyList, err := u.urlPolicyRepository.GetApplicableURLPolicyList(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	var hasAllowPolicy, allowed bool
+	for _, urlPolicy := range urlPolicyList {
+		matcher, err := newURLPolicyMatcher(urlPolicy)
+		if err != nil {
+			logger.With(zap.Uint64("url_policy_id", urlPolicy.ID)).With(zap.Error(err)).Warn("ignoring invalid url policy")
+			continue
+		}
+
+		if urlPolicy.Action == goload.UrlPolicyAction_Allow {
+			hasAllowPolicy = true
+		}
+
+		if !matcher.matches(parsedURL) {
+			continue
+		}
+
+		if urlPolicy.Action == goload.UrlPolicyAction_Deny {
+			logger.With(zap.Uint64("url_policy_id", urlPolicy.ID)).Info("download url is denied by url policy")
+			return errURLDeniedByPolicy
+		}
+
+		allowed = true
+	}
+
+	if hasAllowPolicy && !allowed {
+		logger.Info("download url is not allowed by any url policy")
+		return errURLDeniedByPolicy
+	}
+
+	return nil
+}
+
+func (u urlPolicyService) checkManageURLPoliciesPermission(ctx context.Context, adminAccountID uint64) error {
+	adminAccount, err := u.accountRepository.GetAccountByID(ctx, adminAccountID)
+	if err != nil {
+		return err
+	}
+
+	return checkPermission(adminAccount, PermissionManageURLPolicies)
+}
+
+func (u urlPolicyService) toProtoURLPolicy(urlPolicy database.URLPolicy) *goload.UrlPolicy {
+	return &goload.UrlPolicy{
+		Id:          urlPolicy.ID,
+		OfAccountId: uint64(urlPolicy.OfAccountID.Int64),
+		Action:      urlPolicy.Action,
+		HostGlob:    urlPolicy.HostGlob,
+		UrlRegex:    urlPolicy.URLRegex,
+		Scheme:      urlPolicy.Scheme,
+		Port:        urlPolicy.Port,
+		Description: urlPolicy.Description,
+		CreatedAt:   timestamppb.New(urlPolicy.CreatedAt),
+	}
+}
@@ -0,0 +1,152 @@
+package logic
+
+import (
+	"net/url"
+	"testing"
+
+	"goload/internal/dataaccess/database"
+	"goload/internal/generated/grpc/goload"
+)
+
+func TestURLPolicyMatcherMatches(t *testing.T) {
+	testCases := []struct {
+		name      string
+		urlPolicy database.URLPolicy
+		rawURL    string
+		expected  bool
+	}{
+		{
+			name:      "exact host",
+			urlPolicy: database.URLPolicy{HostGlob: "example.com"},
+			rawURL:    "https://example.com/file",
+			expected:  true,
+		},
+		{
+			name:      "exact host does not match a longer host",
+			urlPolicy: database.URLPolicy{HostGlob: "example.com"},
+			rawURL:    "https://evil-example.com/file",
+			expected:  false,
+		},
+		{
+			name:      "wildcard subdomain",
+			urlPolicy: database.URLPolicy{HostGlob: "*.example.com"},
+			rawURL:    "https://cdn.example.com/file",
+			expected:  true,
+		},
+		{
+			name:      "wildcard subdomain does not match the bare domain",
+			urlPolicy: database.URLPolicy{HostGlob: "*.example.com"},
+			rawURL:    "https://example.com/file",
+			expected:  false,
+		},
+		{
+			name:      "wildcard subdomain does not match another domain",
+			urlPolicy: database.URLPolicy{HostGlob: "*.example.com"},
+			rawURL:    "https://cdn.example.com.evil.net/file",
+			expected:  false,
+		},
+		{
+			name:      "character class",
+			urlPolicy: database.URLPolicy{HostGlob: "mirror[0-9].example.com"},
+			rawURL:    "http://mirror7.example.com/file",
+			expected:  true,
+		},
+		{
+			name:      "host glob ignores case and the trailing dot",
+			urlPolicy: database.URLPolicy{HostGlob: "CDN.Example.com."},
+			rawURL:    "https://cdn.example.COM./file",
+			expected:  true,
+		},
+		{
+			name:      "host glob ignores the port",
+			urlPolicy: database.URLPolicy{HostGlob: "example.com"},
+			rawURL:    "https://example.com:8443/file",
+			expected:  true,
+		},
+		{
+			name:      "scheme",
+			urlPolicy: database.URLPolicy{Scheme: "https"},
+			rawURL:    "HTTPS://example.com/file",
+			expected:  true,
+		},
+		{
+			name:      "other scheme",
+			urlPolicy: database.URLPolicy{Scheme: "https"},
+			rawURL:    "http://example.com/file",
+			expected:  false,
+		},
+		{
+			name:      "default https port",
+			urlPolicy: database.URLPolicy{Port: 443},
+			rawURL:    "https://example.com/file",
+			expected:  true,
+		},
+		{
+			name:      "default http port",
+			urlPolicy: database.URLPolicy{Port: 443},
+			rawURL:    "http://example.com/file",
+			expected:  false,
+		},
+		{
+			name:      "explicit port",
+			urlPolicy: database.URLPolicy{Port: 8080},
+			rawURL:    "http://example.com:8080/file",
+			expected:  true,
+		},
+		{
+			name:      "explicit default port",
+			urlPolicy: database.URLPolicy{Port: 80},
+			rawURL:    "http://example.com:80/file",
+			expected:  true,
+		},
+		{
+			name:      "other explicit port",
+			urlPolicy: database.URLPolicy{Port: 8080},
+			rawURL:    "http://example.com:8081/file",
+			expected:  false,
+		},
+		{
+			name:      "url regex",
+			urlPolicy: database.URLPolicy{URLRegex: `^https://example\.com/files/.+\.iso$`},
+			rawURL:    "https://example.com/files/linux.iso",
+			expected:  true,
+		},
+		{
+			name:      "url regex does not match",
+			urlPolicy: database.URLPolicy{URLRegex: `^https://example\.com/files/.+\.iso$`},
+			rawURL:    "https://example.com/other/linux.iso",
+			expected:  false,
+		},
+		{
+			name:      "every condition matches",
+			urlPolicy: database.URLPolicy{HostGlob: "*.example.com", Scheme: "https", Port: 8443},
+			rawURL:    "https://cdn.example.com:8443/file",
+			expected:  true,
+		},
+		{
+			name:      "one condition does not match",
+			urlPolicy: database.URLPolicy{HostGlob: "*.example.com", Scheme: "https", Port: 8443},
+			rawURL:    "https://cdn.example.com/file",
+			expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.urlPolicy.Action = goload.UrlPolicyAction_Deny
+			matcher, err := newURLPolicyMatcher(testCase.urlPolicy)
+			if err != nil {
+				t.Fatalf("failed to create url policy matcher: %v", err)
+			}
+
+			parsedURL, err := url.Parse(testCase.rawURL)
+			if err != nil {
+				t.Fatalf("failed to parse %s: %v", testCase.rawURL, err)
+			}
+
+			if matched := matcher.matches(parsedURL); matched != testCase.expected {
+				t.Fatalf("%s matched: %t, expected %t", testCase.rawURL, matched, testCase.expected)
+			}
+		})
+	}
+}
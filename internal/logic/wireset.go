@@ -9,5 +9,7 @@ var WireSet = wire.NewSet(
 	NewHashService,
 	NewTokenService,
 	NewDownloadTaskService,
-	NewHttpDownloader,
+	NewOutboxService,
+	NewApiKeyService,
+	NewURLPolicyService,
 )
@@ -11,13 +11,16 @@ import (
 	"goload/internal/app"
 	"goload/internal/configs"
 	"goload/internal/dataaccess"
+	"goload/internal/dataaccess/cache"
 	"goload/internal/dataaccess/database"
 	"goload/internal/dataaccess/file"
 	"goload/internal/dataaccess/mq/consumer"
 	"goload/internal/dataaccess/mq/producer"
+	"goload/internal/dataaccess/notifier"
 	"goload/internal/handler"
 	"goload/internal/handler/grpc"
 	"goload/internal/handler/http"
+	"goload/internal/handler/jobs"
 	"goload/internal/handler/mq"
 	"goload/internal/logic"
 	"goload/internal/utils"
@@ -44,49 +47,106 @@ func InitializeServer(configFilePath configs.ConfigFilePath) (*app.Server, func(
 	goquDatabase := database.InitializeGoquDB(db)
 	accountRepository := database.NewAccountRepository(goquDatabase)
 	accountPasswordRepository := database.NewAccountPasswordRepository(goquDatabase)
+	sessionRepository := database.NewSessionRepository(goquDatabase, logger)
+	passwordResetTokenRepository := database.NewPasswordResetTokenRepository(goquDatabase, logger)
 	auth := config.Auth
-	hashService := logic.NewHashService(auth)
+	hashService, err := logic.NewHashService(auth)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	publicKeyRepository := database.NewPublicKeyRepository(goquDatabase)
-	tokenService, err := logic.NewTokenService(accountRepository, publicKeyRepository, auth)
+	configsCache := config.Cache
+	client, err := cache.NewClient(configsCache, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	accountService := logic.NewAccountService(goquDatabase, accountRepository, accountPasswordRepository, hashService, tokenService)
-	downloadTaskRepository := database.NewDownloadRepository(goquDatabase, logger)
-	configsMQ := config.MQ
-	client, cleanup3, err := producer.NewClient(configsMQ, logger)
+	revokedSession := cache.NewRevokedSession(client, logger)
+	tokenService, err := logic.NewTokenService(goquDatabase, accountRepository, publicKeyRepository, revokedSession, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginAttempt := cache.NewLoginAttempt(client, logger)
+	configsNotifier := config.Notifier
+	notifierNotifier, err := notifier.NewNotifier(configsNotifier, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountService, err := logic.NewAccountService(goquDatabase, accountRepository, accountPasswordRepository, sessionRepository, passwordResetTokenRepository, hashService, tokenService, loginAttempt, notifierNotifier, auth, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	downloadTaskCreatedProducer := producer.NewDownloadTaskCreatedProducer(client, logger)
+	apiKeyRepository := database.NewApiKeyRepository(goquDatabase, logger)
+	apiKeyService := logic.NewApiKeyService(accountRepository, apiKeyRepository, hashService, logger)
+	downloadTaskRepository := database.NewDownloadRepository(goquDatabase, logger)
+	outboxMessageRepository := database.NewOutboxMessageRepository(goquDatabase, logger)
+	urlPolicyRepository := database.NewURLPolicyRepository(goquDatabase, logger)
+	urlPolicyService := logic.NewURLPolicyService(accountRepository, urlPolicyRepository, logger)
 	download := config.Download
 	fileClient, err := file.NewClient(download, logger)
 	if err != nil {
-		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	downloadTaskService := logic.NewDownloadTaskService(goquDatabase, downloadTaskRepository, accountRepository, downloadTaskCreatedProducer, fileClient, logger)
-	goLoadServiceServer := grpc.NewHandler(accountService, downloadTaskService, tokenService)
+	downloadTaskInterruption := cache.NewDownloadTaskInterruption(client, logger)
+	downloadTaskProgress := cache.NewDownloadTaskProgress(client, logger)
+	downloadTaskService, err := logic.NewDownloadTaskService(goquDatabase, downloadTaskRepository, accountRepository, outboxMessageRepository, urlPolicyService, fileClient, downloadTaskInterruption, downloadTaskProgress, download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	goLoadServiceServer := grpc.NewHandler(accountService, apiKeyService, downloadTaskService, urlPolicyService)
+	authInterceptor := grpc.NewAuthInterceptor(tokenService, apiKeyService, logger)
+	validationInterceptor := grpc.NewValidationInterceptor(logger)
 	configsGRPC := config.GRPC
-	server := grpc.NewServer(goLoadServiceServer, configsGRPC, logger)
+	server := grpc.NewServer(goLoadServiceServer, authInterceptor, validationInterceptor, configsGRPC, logger)
 	configsHTTP := config.HTTP
-	httpServer := http.NewServer(configsHTTP, configsGRPC, logger)
+	httpServer := http.NewServer(configsHTTP, configsGRPC, tokenService, logger)
 	downloadTaskCreated := mq.NewDownloadTaskCreated(downloadTaskService, logger)
+	configsMQ := config.MQ
 	consumerConsumer, err := consumer.NewConsumer(configsMQ, logger)
 	if err != nil {
-		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	messageConsumer := mq.NewMessageConsumer(downloadTaskCreated, consumerConsumer, logger)
-	appServer := app.NewServer(server, httpServer, messageConsumer, logger)
+	retryDownloadTasks := jobs.NewRetryDownloadTasks(downloadTaskService, logger)
+	producerClient, cleanup3, err := producer.NewClient(configsMQ, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outboxService, err := logic.NewOutboxService(goquDatabase, outboxMessageRepository, producerClient, configsMQ, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	relayOutboxMessages := jobs.NewRelayOutboxMessages(outboxService, logger)
+	reapStuckDownloadTasks := jobs.NewReapStuckDownloadTasks(downloadTaskService, logger)
+	bootstrapAdminAccounts := jobs.NewBootstrapAdminAccounts(accountService, logger)
+	scheduler, err := jobs.NewScheduler(retryDownloadTasks, relayOutboxMessages, reapStuckDownloadTasks, bootstrapAdminAccounts, download, configsMQ, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	appServer := app.NewServer(server, httpServer, messageConsumer, scheduler, logger)
 	return appServer, func() {
 		cleanup3()
 		cleanup2()